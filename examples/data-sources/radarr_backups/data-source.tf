@@ -0,0 +1,2 @@
+data "radarr_backups" "example" {
+}
@@ -0,0 +1,3 @@
+data "radarr_blocklist" "example" {
+  movie_ids = [1, 2]
+}
@@ -0,0 +1,11 @@
+data "radarr_calendar" "example" {
+  start       = "2024-06-01T00:00:00Z"
+  end         = "2024-07-01T00:00:00Z"
+  unmonitored = true
+  tags        = [1]
+}
+
+# coming this month
+output "digital_releases" {
+  value = { for m in data.radarr_calendar.example.movies : m.title => m.digital_release if m.digital_release != null }
+}
@@ -0,0 +1,3 @@
+data "radarr_collection" "example" {
+  title = "The Lord of the Rings Collection"
+}
@@ -0,0 +1,7 @@
+data "radarr_disk_space" "example" {
+}
+
+# mounts with less than 10% of free space
+output "nearly_full" {
+  value = [for d in data.radarr_disk_space.example.disk_spaces : d.path if d.free_space < d.total_space / 10]
+}
@@ -0,0 +1,9 @@
+data "radarr_health" "example" {
+}
+
+check "health" {
+  assert {
+    condition     = data.radarr_health.example.ok
+    error_message = join("\n", [for c in data.radarr_health.example.checks : "${c.source}: ${c.message}"])
+  }
+}
@@ -0,0 +1,10 @@
+data "radarr_history" "example" {
+  movie_id   = 1
+  event_type = "grabbed"
+  since      = "2024-01-01T00:00:00Z"
+}
+
+# indexers the releases were grabbed from
+output "grabbed_indexers" {
+  value = distinct([for h in data.radarr_history.example.items : lookup(h.data, "indexer", "")])
+}
@@ -0,0 +1,8 @@
+data "radarr_movie_credits" "example" {
+  tmdb_id = 603
+}
+
+# directors of the movie
+output "directors" {
+  value = [for c in data.radarr_movie_credits.example.credits : c.person_name if c.job == "Director"]
+}
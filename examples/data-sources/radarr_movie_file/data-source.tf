@@ -0,0 +1,11 @@
+data "radarr_movie_file" "example" {
+  movie_id = 1
+}
+
+# audit the file against the expected quality
+check "uhd" {
+  assert {
+    condition     = data.radarr_movie_file.example.media_info.resolution == "3840x2160"
+    error_message = "Movie file is not 4K."
+  }
+}
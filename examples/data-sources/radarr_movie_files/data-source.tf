@@ -0,0 +1,7 @@
+data "radarr_movie_files" "example" {
+}
+
+# only the files of the given movies
+data "radarr_movie_files" "filtered" {
+  movie_ids = [1, 2]
+}
@@ -0,0 +1,7 @@
+data "radarr_movie_lookup" "example" {
+  term = "The Matrix"
+}
+
+data "radarr_movie_lookup" "by_imdb" {
+  imdb_id = "tt0133093"
+}
@@ -0,0 +1,8 @@
+data "radarr_movie_rename_preview" "example" {
+  movie_ids = [1, 2, 3]
+}
+
+# single movie
+data "radarr_movie_rename_preview" "movie" {
+  movie_id = 1
+}
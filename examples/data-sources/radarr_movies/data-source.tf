@@ -1,2 +1,14 @@
 data "radarr_movies" "example" {
 }
+
+data "radarr_movies" "missing" {
+  monitored        = true
+  has_file         = false
+  year_min         = 2020
+  root_folder_path = "/movies"
+}
+
+# missing movies already released digitally
+output "digitally_released_missing" {
+  value = [for m in data.radarr_movies.missing.movies : m.title if m.digital_release != null && timecmp(m.digital_release, plantimestamp()) < 0]
+}
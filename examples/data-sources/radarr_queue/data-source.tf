@@ -0,0 +1,24 @@
+data "radarr_queue" "example" {
+}
+
+data "radarr_queue" "torrent_warnings" {
+  download_client_id = 1
+  protocol           = "torrent"
+  status             = "warning"
+}
+
+# downloads stuck waiting for import
+data "radarr_queue" "import_pending" {
+  tracked_download_state = "importPending"
+}
+
+output "import_pending" {
+  value = data.radarr_queue.import_pending.items[*].title
+}
+
+check "queue" {
+  assert {
+    condition     = length([for q in data.radarr_queue.example.items : q if q.tracked_download_status != "ok"]) == 0
+    error_message = "Some queue items need attention."
+  }
+}
@@ -1,3 +1,19 @@
 data "radarr_root_folder" "example" {
   path = "/example"
-}
\ No newline at end of file
+}
+# block adding a movie to a nearly full root folder
+resource "radarr_movie" "example" {
+  monitored            = false
+  title                = "The Matrix"
+  path                 = "${data.radarr_root_folder.example.path}/The_Matrix_1999"
+  quality_profile_id   = 1
+  tmdb_id              = 603
+  minimum_availability = "inCinemas"
+
+  lifecycle {
+    precondition {
+      condition     = data.radarr_root_folder.example.free_space > 50 * 1024 * 1024 * 1024
+      error_message = "Root folder has less than 50GB of free space."
+    }
+  }
+}
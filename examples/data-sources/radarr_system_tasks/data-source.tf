@@ -0,0 +1,13 @@
+data "radarr_system_tasks" "example" {
+}
+
+# fail when a sync did not run within its interval
+check "sync_tasks" {
+  assert {
+    condition = alltrue([
+      for t in data.radarr_system_tasks.example.tasks : timecmp(timeadd(t.last_execution, "${t.interval * 2}m"), plantimestamp()) > 0
+      if contains(["RssSync", "ImportListSync"], t.task_name)
+    ])
+    error_message = "RSS or import list sync is late."
+  }
+}
@@ -0,0 +1,6 @@
+data "radarr_updates" "example" {
+}
+
+output "pending_version" {
+  value = one([for u in data.radarr_updates.example.updates : u.version if u.latest && !u.installed])
+}
@@ -0,0 +1,7 @@
+data "radarr_wanted_cutoff" "example" {
+}
+
+# movies below cutoff per quality profile
+output "cutoff_unmet" {
+  value = { for m in data.radarr_wanted_cutoff.example.movies : tostring(m.quality_profile_id) => m.title... }
+}
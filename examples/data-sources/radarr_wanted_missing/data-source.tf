@@ -0,0 +1,2 @@
+data "radarr_wanted_missing" "example" {
+}
@@ -0,0 +1,7 @@
+# fresh backup before every apply, keeping two weeks of backups
+resource "radarr_backup" "example" {
+  delete_older_than_days = 14
+  triggers = {
+    run = plantimestamp()
+  }
+}
@@ -0,0 +1,15 @@
+# delete items older than 30 days on every apply
+resource "radarr_blocklist_cleanup" "example" {
+  older_than_days = 30
+  triggers = {
+    run = plantimestamp()
+  }
+}
+
+# delete items of a single indexer
+resource "radarr_blocklist_cleanup" "indexer" {
+  indexer = "Example"
+  triggers = {
+    version = "1"
+  }
+}
@@ -0,0 +1,2 @@
+# import using the API/UI ID
+terraform import radarr_collection.example 10
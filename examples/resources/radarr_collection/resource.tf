@@ -0,0 +1,8 @@
+resource "radarr_collection" "example" {
+  tmdb_id              = 119
+  monitored            = true
+  quality_profile_id   = 1
+  root_folder_path     = "/movies"
+  minimum_availability = "released"
+  search_on_add        = true
+}
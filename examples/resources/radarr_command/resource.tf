@@ -0,0 +1,11 @@
+resource "radarr_command" "example" {
+  name = "RefreshMovie"
+  body = jsonencode({
+    movieIds = [1, 2]
+  })
+  timeout = 300
+
+  triggers = {
+    movies = "1,2"
+  }
+}
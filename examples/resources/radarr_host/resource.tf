@@ -5,6 +5,15 @@ resource "radarr_host" "test" {
   bind_address    = "*"
   application_url = ""
   instance_name   = "Radarr"
+
+  # restart when port, bind address, URL base, SSL or authentication method change
+  restart_on_change = true
+
+  # reset the API key every quarter, the provider switches to the new one right away
+  rotate_api_key = {
+    quarter = "2026-Q4"
+  }
+
   proxy = {
     enabled = false
   }
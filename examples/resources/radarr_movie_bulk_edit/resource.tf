@@ -0,0 +1,12 @@
+resource "radarr_movie_bulk_edit" "example" {
+  selector = {
+    quality_profile_id = 1
+    root_folder_path   = "/movies"
+    year_min           = 2000
+  }
+
+  quality_profile_id = 4
+  monitored          = true
+  tags               = [1, 2]
+  apply_tags         = "add"
+}
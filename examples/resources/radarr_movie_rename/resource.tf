@@ -0,0 +1,13 @@
+resource "radarr_naming" "example" {
+  rename_movies              = true
+  replace_illegal_characters = true
+  colon_replacement_format   = "dash"
+  standard_movie_format      = "{Movie Title} ({Release Year}) {Quality Full}"
+  movie_folder_format        = "{Movie Title} ({Release Year})"
+}
+
+resource "radarr_movie_rename" "example" {
+  triggers = {
+    naming = sha1(jsonencode(radarr_naming.example))
+  }
+}
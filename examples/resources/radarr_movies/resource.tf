@@ -0,0 +1,8 @@
+resource "radarr_movies" "example" {
+  tmdb_ids             = [603, 604, 605]
+  monitored            = true
+  quality_profile_id   = 1
+  root_folder_path     = "/movies"
+  minimum_availability = "released"
+  tags                 = [1]
+}
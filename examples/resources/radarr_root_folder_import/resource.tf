@@ -0,0 +1,10 @@
+resource "radarr_root_folder" "example" {
+  path = "/movies"
+}
+
+resource "radarr_root_folder_import" "example" {
+  root_folder_id     = radarr_root_folder.example.id
+  quality_profile_id = 1
+  monitored          = true
+  tags               = [1]
+}
@@ -0,0 +1,4 @@
+resource "radarr_update" "example" {
+  version = "5.8.3.8933"
+  timeout = 900
+}
@@ -0,0 +1,121 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/devopsarr/radarr-go/radarr"
+)
+
+// CommandPollInterval is the default interval between two command status checks.
+const CommandPollInterval = 2 * time.Second
+
+var (
+	ErrCommandFailed  = errors.New("command did not complete")
+	ErrCommandTimeout = errors.New("command timed out")
+)
+
+// CreateCommand posts a command with its parameters at the root of the body,
+// the generated client only supports commands without parameters.
+func CreateCommand(ctx context.Context, client *radarr.APIClient, name string, params map[string]interface{}) (*radarr.CommandResource, error) {
+	config := client.GetConfig()
+
+	url, err := config.ServerURLWithContext(ctx, "CommandAPIService.CreateCommand")
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{}
+	for k, v := range params {
+		body[k] = v
+	}
+
+	body["name"] = name
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url+"/api/v3/command", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", config.UserAgent)
+
+	for k, v := range config.DefaultHeader {
+		req.Header.Set(k, v)
+	}
+
+	if auth, ok := ctx.Value(radarr.ContextAPIKeys).(map[string]radarr.APIKey); ok {
+		if key, ok := auth["X-Api-Key"]; ok {
+			req.Header.Set("X-Api-Key", key.Key)
+		}
+	}
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("%s\nDetails:\n%s", resp.Status, string(respBody))
+	}
+
+	command := radarr.CommandResource{}
+	if err := json.Unmarshal(respBody, &command); err != nil {
+		return nil, err
+	}
+
+	return &command, nil
+}
+
+// WaitCommand polls a command until it reaches a final status, the timeout expires or ctx is cancelled.
+func WaitCommand(ctx context.Context, client *radarr.APIClient, id int32, timeout, interval time.Duration) (*radarr.CommandResource, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		command, _, err := client.CommandAPI.GetCommandById(ctx, id).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		switch command.GetStatus() {
+		case radarr.COMMANDSTATUS_COMPLETED:
+			return command, nil
+		case radarr.COMMANDSTATUS_FAILED, radarr.COMMANDSTATUS_ABORTED, radarr.COMMANDSTATUS_CANCELLED, radarr.COMMANDSTATUS_ORPHANED:
+			return command, fmt.Errorf("%w: %s %s: %s", ErrCommandFailed, command.GetName(), command.GetStatus(), command.GetMessage())
+		case radarr.COMMANDSTATUS_QUEUED, radarr.COMMANDSTATUS_STARTED:
+		}
+
+		if time.Now().After(deadline) {
+			return command, fmt.Errorf("%w: %s still %s after %s", ErrCommandTimeout, command.GetName(), command.GetStatus(), timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return command, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCommandClient(t *testing.T, handler http.HandlerFunc) (context.Context, *radarr.APIClient) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	config := radarr.NewConfiguration()
+	config.Servers[0].URL = server.URL
+	ctx := context.WithValue(context.Background(), radarr.ContextAPIKeys, map[string]radarr.APIKey{"X-Api-Key": {Key: "key"}})
+
+	return ctx, radarr.NewAPIClient(config)
+}
+
+func TestCreateCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status   int
+		response string
+		expected map[string]interface{}
+		err      bool
+	}{
+		"working": {
+			status:   http.StatusCreated,
+			response: `{"id": 1, "name": "RenameMovie", "status": "queued"}`,
+			expected: map[string]interface{}{"name": "RenameMovie", "movieIds": []interface{}{float64(1)}},
+		},
+		"error": {
+			status:   http.StatusBadRequest,
+			response: `{"message": "invalid"}`,
+			expected: map[string]interface{}{"name": "RenameMovie", "movieIds": []interface{}{float64(1)}},
+			err:      true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var body map[string]interface{}
+
+			ctx, client := testCommandClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "key", r.Header.Get("X-Api-Key"))
+				assert.Equal(t, "/api/v3/command", r.URL.Path)
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.response))
+			})
+
+			command, err := CreateCommand(ctx, client, "RenameMovie", map[string]interface{}{"movieIds": []int32{1}})
+			assert.Equal(t, test.expected, body)
+
+			if test.err {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, int32(1), command.GetId())
+		})
+	}
+}
+
+func TestWaitCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		statuses []string
+		err      error
+	}{
+		"completed": {
+			statuses: []string{"queued", "started", "completed"},
+		},
+		"failed": {
+			statuses: []string{"started", "failed"},
+			err:      ErrCommandFailed,
+		},
+		"timeout": {
+			statuses: []string{"started"},
+			err:      ErrCommandTimeout,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			call := 0
+
+			ctx, client := testCommandClient(t, func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id": 1, "name": "RenameMovie", "status": "` + test.statuses[call] + `"}`))
+				if call < len(test.statuses)-1 {
+					call++
+				}
+			})
+
+			_, err := WaitCommand(ctx, client, 1, 2*time.Millisecond, time.Millisecond)
+			if test.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestWaitCommandCancelled(t *testing.T) {
+	t.Parallel()
+
+	auth, client := testCommandClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1, "name": "RenameMovie", "status": "started"}`))
+	})
+
+	ctx, cancel := context.WithCancel(auth)
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	// the interval is longer than the test timeout, only the cancellation stops the wait
+	_, err := WaitCommand(ctx, client, 1, time.Hour, time.Hour)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,23 @@
+package helpers
+
+import "context"
+
+// authContext is cancelled with the request context and carries the values of the auth context.
+type authContext struct {
+	context.Context
+	auth context.Context
+}
+
+func (c authContext) Value(key interface{}) interface{} {
+	if value := c.auth.Value(key); value != nil {
+		return value
+	}
+
+	return c.Context.Value(key)
+}
+
+// WithAuth returns a context cancelled with ctx, usually the request one,
+// carrying the API key and server values of auth, the one built when configuring.
+func WithAuth(ctx, auth context.Context) context.Context {
+	return authContext{Context: ctx, auth: auth}
+}
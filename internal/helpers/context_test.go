@@ -0,0 +1,31 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithAuth(t *testing.T) {
+	t.Parallel()
+
+	type key string
+
+	keys := map[string]radarr.APIKey{"X-Api-Key": {Key: "key"}}
+	auth := context.WithValue(context.Background(), radarr.ContextAPIKeys, keys)
+	request, cancel := context.WithCancel(context.WithValue(context.Background(), key("request"), "value"))
+
+	ctx := WithAuth(request, auth)
+
+	assert.Equal(t, keys, ctx.Value(radarr.ContextAPIKeys))
+	assert.Equal(t, "value", ctx.Value(key("request")))
+	assert.NoError(t, ctx.Err())
+
+	cancel()
+
+	<-ctx.Done()
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	assert.NoError(t, auth.Err())
+}
@@ -0,0 +1,39 @@
+package helpers
+
+import "net/http"
+
+// PagingResource is implemented by the paged responses of the client.
+type PagingResource[T any] interface {
+	GetRecords() []T
+	GetTotalRecords() int32
+}
+
+// ListPages fetches all the pages of a paged endpoint, starting from the first one.
+// If stop is set, listing ends at the first record it matches, which is not included.
+func ListPages[T any, P PagingResource[T]](fetch func(page int32) (P, *http.Response, error), stop func(*T) bool) ([]T, error) {
+	var (
+		items   []T
+		fetched int
+	)
+
+	for page := int32(1); ; page++ {
+		response, _, err := fetch(page)
+		if err != nil {
+			return nil, err
+		}
+
+		records := response.GetRecords()
+		for i := range records {
+			if stop != nil && stop(&records[i]) {
+				return items, nil
+			}
+
+			items = append(items, records[i])
+		}
+
+		fetched += len(records)
+		if len(records) == 0 || fetched >= int(response.GetTotalRecords()) {
+			return items, nil
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package helpers
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/stretchr/testify/assert"
+)
+
+var errTestPage = errors.New("page error")
+
+func TestListPages(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pages    [][]int32
+		total    int32
+		stop     int32
+		expected []int32
+		calls    int32
+		err      error
+	}{
+		"single page": {
+			pages:    [][]int32{{1, 2}},
+			total:    2,
+			expected: []int32{1, 2},
+			calls:    1,
+		},
+		"multiple pages": {
+			pages:    [][]int32{{1, 2}, {3, 4}, {5}},
+			total:    5,
+			expected: []int32{1, 2, 3, 4, 5},
+			calls:    3,
+		},
+		"empty page": {
+			pages:    [][]int32{{1, 2}, {}},
+			total:    10,
+			expected: []int32{1, 2},
+			calls:    2,
+		},
+		"stop": {
+			pages:    [][]int32{{1, 2}, {3, 4}, {5}},
+			total:    5,
+			stop:     4,
+			expected: []int32{1, 2, 3},
+			calls:    2,
+		},
+		"error": {
+			pages: [][]int32{{1, 2}},
+			total: 5,
+			calls: 2,
+			err:   errTestPage,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+
+			fetch := func(page int32) (*radarr.BlocklistResourcePagingResource, *http.Response, error) {
+				calls++
+
+				if int(page) > len(test.pages) {
+					return nil, nil, errTestPage
+				}
+
+				records := make([]radarr.BlocklistResource, len(test.pages[page-1]))
+				for i, id := range test.pages[page-1] {
+					records[i].SetId(id)
+				}
+
+				response := radarr.NewBlocklistResourcePagingResource()
+				response.SetRecords(records)
+				response.SetTotalRecords(test.total)
+
+				return response, nil, nil
+			}
+
+			var stop func(*radarr.BlocklistResource) bool
+			if test.stop != 0 {
+				stop = func(b *radarr.BlocklistResource) bool { return b.GetId() == test.stop }
+			}
+
+			items, err := ListPages(fetch, stop)
+			assert.Equal(t, test.calls, calls)
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			IDs := make([]int32, len(items))
+			for i, item := range items {
+				IDs[i] = item.GetId()
+			}
+
+			assert.Equal(t, test.expected, IDs)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/devopsarr/radarr-go/radarr"
+)
+
+var ErrSystemTimeout = errors.New("system status timed out")
+
+// WaitSystemStatus polls the system status until check is satisfied, the timeout expires or ctx is cancelled.
+// Request errors are retried, since the application is not reachable while restarting.
+func WaitSystemStatus(ctx context.Context, client *radarr.APIClient, timeout, interval time.Duration, check func(*radarr.SystemResource) bool) (*radarr.SystemResource, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		status, _, err := client.SystemAPI.GetSystemStatus(ctx).Execute()
+		if err == nil && check(status) {
+			return status, nil
+		}
+
+		if time.Now().After(deadline) {
+			if err != nil {
+				return nil, fmt.Errorf("%w after %s: %w", ErrSystemTimeout, timeout, err)
+			}
+
+			return status, fmt.Errorf("%w after %s: version %s", ErrSystemTimeout, timeout, status.GetVersion())
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitSystemStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		responses []string
+		version   string
+		err       error
+	}{
+		"immediate": {
+			responses: []string{`{"version": "5.0.0"}`},
+			version:   "5.0.0",
+		},
+		"restarting": {
+			responses: []string{"", "", `{"version": "4.0.0"}`, `{"version": "5.0.0"}`},
+			version:   "5.0.0",
+		},
+		"timeout": {
+			responses: []string{`{"version": "4.0.0"}`},
+			err:       ErrSystemTimeout,
+		},
+		"unreachable": {
+			responses: []string{""},
+			err:       ErrSystemTimeout,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+
+			ctx, client := testCommandClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v3/system/status", r.URL.Path)
+
+				response := test.responses[min(int(calls.Add(1))-1, len(test.responses)-1)]
+				if response == "" {
+					w.WriteHeader(http.StatusServiceUnavailable)
+
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(response))
+			})
+
+			status, err := WaitSystemStatus(ctx, client, 50*time.Millisecond, time.Millisecond, func(s *radarr.SystemResource) bool {
+				return s.GetVersion() == "5.0.0"
+			})
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.version, status.GetVersion())
+		})
+	}
+}
+
+func TestWaitSystemStatusCancelled(t *testing.T) {
+	t.Parallel()
+
+	auth, client := testCommandClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	ctx, cancel := context.WithCancel(auth)
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	// the interval is longer than the test timeout, only the cancellation stops the wait
+	_, err := WaitSystemStatus(ctx, client, time.Hour, time.Hour, func(*radarr.SystemResource) bool { return true })
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,16 @@
+package helpers
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TimeValue returns the RFC3339 representation of a time, null if the time is not set.
+func TimeValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(t.Format(time.RFC3339))
+}
@@ -0,0 +1,37 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeValue(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2024, time.March, 10, 20, 30, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		value    *time.Time
+		expected types.String
+	}{
+		"working": {
+			value:    &date,
+			expected: types.StringValue("2024-03-10T20:30:00Z"),
+		},
+		"nil": {
+			value:    nil,
+			expected: types.StringNull(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, TimeValue(test.value))
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const backupResourceName = "backup"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource               = &BackupResource{}
+	_ resource.ResourceWithModifyPlan = &BackupResource{}
+)
+
+func NewBackupResource() resource.Resource {
+	return &BackupResource{}
+}
+
+// BackupResource defines the backup implementation.
+type BackupResource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// BackupRun describes the backup data model.
+type BackupRun struct {
+	Triggers            types.Map    `tfsdk:"triggers"`
+	Deleted             types.Set    `tfsdk:"deleted"`
+	Name                types.String `tfsdk:"name"`
+	Time                types.String `tfsdk:"time"`
+	ID                  types.Int64  `tfsdk:"id"`
+	Size                types.Int64  `tfsdk:"size"`
+	DeleteOlderThanDays types.Int64  `tfsdk:"delete_older_than_days"`
+	Timeout             types.Int64  `tfsdk:"timeout"`
+}
+
+func (r *BackupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + backupResourceName
+}
+
+func (r *BackupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nBackup resource.\nRuns a manual backup on create and whenever `triggers` change, waiting for it to complete, then deletes the [Backups](../data-sources/backups) older than `delete_older_than_days`.\nDestroying it does not delete the backup.\nFor more information refer to [Backup](https://wiki.servarr.com/radarr/system#backup) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that trigger a new backup when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"delete_older_than_days": schema.Int64Attribute{
+				MarkdownDescription: "Delete backups older than this number of days after each run.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Seconds to wait for the backup to complete. Defaults to `600`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(commandDefaultTimeout),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Backup ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Backup file name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size in bytes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"time": schema.StringAttribute{
+				MarkdownDescription: "Backup time, in RFC3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deleted": schema.SetAttribute{
+				MarkdownDescription: "Backup file names deleted by the last run.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var backup *BackupRun
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.backup(ctx, backup, helpers.Create, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+backupResourceName+": "+backup.Name.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+}
+
+func (r *BackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Backup is an action, keep the last run result
+	var backup *BackupRun
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+backupResourceName+": "+backup.Name.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+}
+
+func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan and state values
+	var backup, state *BackupRun
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &backup)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only a triggers change runs a new backup
+	if !backup.Triggers.Equal(state.Triggers) {
+		r.backup(ctx, backup, helpers.Update, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+backupResourceName+": "+backup.Name.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+}
+
+func (r *BackupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan *BackupRun
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() || plan.Triggers.Equal(state.Triggers) {
+		return
+	}
+
+	// A new backup will be run
+	plan.ID = types.Int64Unknown()
+	plan.Name = types.StringUnknown()
+	plan.Size = types.Int64Unknown()
+	plan.Time = types.StringUnknown()
+	plan.Deleted = types.SetUnknown(types.StringType)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *BackupResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Backup file is kept, it is pruned by retention
+	tflog.Trace(ctx, "decoupled "+backupResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// backup runs the backup command, waits for its completion and prunes the old backups.
+func (r *BackupResource) backup(ctx context.Context, backup *BackupRun, action string, diags *diag.Diagnostics) {
+	command, err := helpers.CreateCommand(r.auth, r.client, "Backup", nil)
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, backupResourceName, err))
+
+		return
+	}
+
+	if _, err := helpers.WaitCommand(helpers.WithAuth(ctx, r.auth), r.client, command.GetId(), time.Duration(backup.Timeout.ValueInt64())*time.Second, helpers.CommandPollInterval); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, backupResourceName, err))
+
+		return
+	}
+
+	backups, _, err := r.client.BackupAPI.ListSystemBackup(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, backupResourceName, err))
+
+		return
+	}
+
+	if !backup.write(backups) {
+		diags.AddError(helpers.ResourceError, helpers.ParseNotFoundError(backupResourceName, "type", string(radarr.BACKUPTYPE_MANUAL)))
+
+		return
+	}
+
+	deleted := make([]string, 0)
+
+	if !backup.DeleteOlderThanDays.IsNull() {
+		limit := time.Now().AddDate(0, 0, -int(backup.DeleteOlderThanDays.ValueInt64()))
+
+		for _, b := range backups {
+			if !b.GetTime().Before(limit) {
+				continue
+			}
+
+			if _, err := r.client.BackupAPI.DeleteSystemBackup(r.auth, b.GetId()).Execute(); err != nil {
+				diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, backupResourceName, err))
+
+				return
+			}
+
+			deleted = append(deleted, b.GetName())
+		}
+	}
+
+	var tempDiag diag.Diagnostics
+
+	backup.Deleted, tempDiag = types.SetValueFrom(ctx, types.StringType, deleted)
+	diags.Append(tempDiag...)
+}
+
+// write maps the newest manual backup, the one just created, returning false if there is none.
+func (b *BackupRun) write(backups []radarr.BackupResource) bool {
+	var newest *radarr.BackupResource
+
+	for i, backup := range backups {
+		if backup.GetType() == radarr.BACKUPTYPE_MANUAL && (newest == nil || backup.GetTime().After(newest.GetTime())) {
+			newest = &backups[i]
+		}
+	}
+
+	if newest == nil {
+		return false
+	}
+
+	b.ID = types.Int64Value(int64(newest.GetId()))
+	b.Name = types.StringValue(newest.GetName())
+	b.Size = types.Int64Value(newest.GetSize())
+	b.Time = helpers.TimeValue(newest.Time)
+
+	return true
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccBackupResourceConfig("first") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccBackupResourceConfig("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_backup.test", "triggers.run", "first"),
+					resource.TestCheckResourceAttrSet("radarr_backup.test", "name"),
+					resource.TestCheckResourceAttrSet("radarr_backup.test", "time"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccBackupResourceConfig("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_backup.test", "triggers.run", "second"),
+					resource.TestCheckResourceAttrSet("radarr_backup.test", "name"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBackupResourceConfig(trigger string) string {
+	return fmt.Sprintf(`
+		resource "radarr_backup" "test" {
+			delete_older_than_days = 30
+			triggers = {
+				run = "%s"
+			}
+		}
+	`, trigger)
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const blocklistCleanupResourceName = "blocklist_cleanup"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                     = &BlocklistCleanupResource{}
+	_ resource.ResourceWithConfigValidators = &BlocklistCleanupResource{}
+)
+
+func NewBlocklistCleanupResource() resource.Resource {
+	return &BlocklistCleanupResource{}
+}
+
+// BlocklistCleanupResource defines the blocklist cleanup implementation.
+type BlocklistCleanupResource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// BlocklistCleanup describes the blocklist cleanup data model.
+type BlocklistCleanup struct {
+	Triggers      types.Map    `tfsdk:"triggers"`
+	DeletedIDs    types.Set    `tfsdk:"deleted_ids"`
+	Indexer       types.String `tfsdk:"indexer"`
+	MovieID       types.Int64  `tfsdk:"movie_id"`
+	OlderThanDays types.Int64  `tfsdk:"older_than_days"`
+}
+
+func (r *BlocklistCleanupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + blocklistCleanupResourceName
+}
+
+func (r *BlocklistCleanupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Activity -->\nBlocklist Cleanup resource.\nDeletes the [Blocklist](../data-sources/blocklist) items matching all the given filters on create and whenever `triggers` change.\nDestroying it does not restore the deleted items.",
+		Attributes: map[string]schema.Attribute{
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that trigger a new cleanup when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"older_than_days": schema.Int64Attribute{
+				MarkdownDescription: "Delete items blocklisted more than this number of days ago.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Delete items of this movie.",
+				Optional:            true,
+			},
+			"indexer": schema.StringAttribute{
+				MarkdownDescription: "Delete items grabbed from this indexer name.",
+				Optional:            true,
+			},
+			"deleted_ids": schema.SetAttribute{
+				MarkdownDescription: "Blocklist item IDs deleted by the last run.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+	}
+}
+
+func (r *BlocklistCleanupResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("older_than_days"),
+			path.MatchRoot("movie_id"),
+			path.MatchRoot("indexer"),
+		),
+	}
+}
+
+func (r *BlocklistCleanupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *BlocklistCleanupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var cleanup *BlocklistCleanup
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &cleanup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.cleanup(ctx, cleanup, helpers.Create, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+blocklistCleanupResourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cleanup)...)
+}
+
+func (r *BlocklistCleanupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Cleanup is an action, keep the last run result
+	var cleanup *BlocklistCleanup
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &cleanup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+blocklistCleanupResourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cleanup)...)
+}
+
+func (r *BlocklistCleanupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var cleanup *BlocklistCleanup
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &cleanup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.cleanup(ctx, cleanup, helpers.Update, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+blocklistCleanupResourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cleanup)...)
+}
+
+func (r *BlocklistCleanupResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Deleted items cannot be restored just removing configuration
+	tflog.Trace(ctx, "decoupled "+blocklistCleanupResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// cleanup bulk deletes the blocklist items matching the filters.
+func (r *BlocklistCleanupResource) cleanup(ctx context.Context, cleanup *BlocklistCleanup, action string, diags *diag.Diagnostics) {
+	var movieIDs []int32
+	if !cleanup.MovieID.IsNull() {
+		movieIDs = []int32{int32(cleanup.MovieID.ValueInt64())}
+	}
+
+	response, err := listBlocklist(r.auth, r.client, movieIDs)
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, blocklistCleanupResourceName, err))
+
+		return
+	}
+
+	ids := make([]int32, 0, len(response))
+
+	for _, b := range response {
+		if cleanup.match(&b) {
+			ids = append(ids, b.GetId())
+		}
+	}
+
+	if len(ids) > 0 {
+		bulk := radarr.NewBlocklistBulkResource()
+		bulk.SetIds(ids)
+
+		if _, err := r.client.BlocklistAPI.DeleteBlocklistBulk(r.auth).BlocklistBulkResource(*bulk).Execute(); err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(action, blocklistCleanupResourceName, err))
+
+			return
+		}
+	}
+
+	var tempDiag diag.Diagnostics
+
+	cleanup.DeletedIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, ids)
+	diags.Append(tempDiag...)
+}
+
+func (b *BlocklistCleanup) match(blocklist *radarr.BlocklistResource) bool {
+	// Movie filter is already applied by the API
+	return (b.Indexer.IsNull() || b.Indexer.ValueString() == blocklist.GetIndexer()) &&
+		(b.OlderThanDays.IsNull() || blocklist.GetDate().Before(time.Now().AddDate(0, 0, -int(b.OlderThanDays.ValueInt64()))))
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBlocklistCleanupResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Missing filter
+			{
+				Config:      `resource "radarr_blocklist_cleanup" "test" {}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Unauthorized Create
+			{
+				Config:      testAccBlocklistCleanupResourceConfig("first") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccBlocklistCleanupResourceConfig("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_blocklist_cleanup.test", "triggers.run", "first"),
+					resource.TestCheckResourceAttr("radarr_blocklist_cleanup.test", "deleted_ids.#", "0"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccBlocklistCleanupResourceConfig("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_blocklist_cleanup.test", "triggers.run", "second"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBlocklistCleanupResourceConfig(trigger string) string {
+	return fmt.Sprintf(`
+		resource "radarr_blocklist_cleanup" "test" {
+			older_than_days = 3650
+			indexer         = "NotExistingIndexer"
+			triggers = {
+				run = "%s"
+			}
+		}
+	`, trigger)
+}
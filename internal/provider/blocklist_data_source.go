@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	blocklistDataSourceName = "blocklist"
+	blocklistPageSize       = 100
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BlocklistDataSource{}
+
+func NewBlocklistDataSource() datasource.DataSource {
+	return &BlocklistDataSource{}
+}
+
+// BlocklistDataSource defines the blocklist implementation.
+type BlocklistDataSource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// Blocklist describes the blocklist data model.
+type Blocklist struct {
+	Items    types.Set    `tfsdk:"items"`
+	MovieIDs types.Set    `tfsdk:"movie_ids"`
+	ID       types.String `tfsdk:"id"`
+}
+
+// BlocklistItem is part of Blocklist.
+type BlocklistItem struct {
+	Languages     types.Set    `tfsdk:"languages"`
+	CustomFormats types.Set    `tfsdk:"custom_formats"`
+	SourceTitle   types.String `tfsdk:"source_title"`
+	Quality       types.String `tfsdk:"quality"`
+	Protocol      types.String `tfsdk:"protocol"`
+	Indexer       types.String `tfsdk:"indexer"`
+	Date          types.String `tfsdk:"date"`
+	Message       types.String `tfsdk:"message"`
+	ID            types.Int64  `tfsdk:"id"`
+	MovieID       types.Int64  `tfsdk:"movie_id"`
+}
+
+func (b BlocklistItem) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"languages":      types.SetType{}.WithElementType(types.StringType),
+			"custom_formats": types.SetType{}.WithElementType(types.StringType),
+			"source_title":   types.StringType,
+			"quality":        types.StringType,
+			"protocol":       types.StringType,
+			"indexer":        types.StringType,
+			"date":           types.StringType,
+			"message":        types.StringType,
+			"id":             types.Int64Type,
+			"movie_id":       types.Int64Type,
+		})
+}
+
+func (d *BlocklistDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + blocklistDataSourceName
+}
+
+func (d *BlocklistDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Activity -->\nList the blocklisted releases.\nFor more information refer to [Blocklist](https://wiki.servarr.com/radarr/activity#blocklist) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"movie_ids": schema.SetAttribute{
+				MarkdownDescription: "Filter by movie IDs.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"items": schema.SetNestedAttribute{
+				MarkdownDescription: "Blocklist item list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Blocklist item ID.",
+							Computed:            true,
+						},
+						"movie_id": schema.Int64Attribute{
+							MarkdownDescription: "Movie ID.",
+							Computed:            true,
+						},
+						"source_title": schema.StringAttribute{
+							MarkdownDescription: "Source release title.",
+							Computed:            true,
+						},
+						"quality": schema.StringAttribute{
+							MarkdownDescription: "Quality name.",
+							Computed:            true,
+						},
+						"languages": schema.SetAttribute{
+							MarkdownDescription: "Language names.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"custom_formats": schema.SetAttribute{
+							MarkdownDescription: "Matched custom format names.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol.",
+							Computed:            true,
+						},
+						"indexer": schema.StringAttribute{
+							MarkdownDescription: "Indexer name.",
+							Computed:            true,
+						},
+						"date": schema.StringAttribute{
+							MarkdownDescription: "Date the release was blocklisted, in RFC3339 format.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Blocklist reason.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BlocklistDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *BlocklistDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Blocklist
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var movieIDs []int32
+
+	resp.Diagnostics.Append(data.MovieIDs.ElementsAs(ctx, &movieIDs, true)...)
+
+	// Get blocklist current value
+	response, err := listBlocklist(d.auth, d.client, movieIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, blocklistDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+blocklistDataSourceName)
+	// Map response body to resource schema attribute
+	items := make([]BlocklistItem, len(response))
+	for i, b := range response {
+		items[i].write(ctx, &b, &resp.Diagnostics)
+	}
+
+	var diags diag.Diagnostics
+
+	data.Items, diags = types.SetValueFrom(ctx, BlocklistItem{}.getType(), items)
+	resp.Diagnostics.Append(diags...)
+
+	data.ID = types.StringValue(strconv.Itoa(len(items)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listBlocklist fetches all the blocklist pages, optionally filtered by movie.
+func listBlocklist(auth context.Context, client *radarr.APIClient, movieIDs []int32) ([]radarr.BlocklistResource, error) {
+	return helpers.ListPages[radarr.BlocklistResource](func(page int32) (*radarr.BlocklistResourcePagingResource, *http.Response, error) {
+		request := client.BlocklistAPI.GetBlocklist(auth).Page(page).PageSize(blocklistPageSize)
+		if len(movieIDs) > 0 {
+			request = request.MovieIds(movieIDs)
+		}
+
+		return request.Execute()
+	}, nil)
+}
+
+func (b *BlocklistItem) write(ctx context.Context, blocklist *radarr.BlocklistResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	b.ID = types.Int64Value(int64(blocklist.GetId()))
+	b.MovieID = types.Int64Value(int64(blocklist.GetMovieId()))
+	b.SourceTitle = types.StringValue(blocklist.GetSourceTitle())
+	b.Protocol = types.StringValue(string(blocklist.GetProtocol()))
+	b.Indexer = types.StringValue(blocklist.GetIndexer())
+	b.Date = helpers.TimeValue(blocklist.Date)
+	b.Message = types.StringValue(blocklist.GetMessage())
+
+	quality := blocklist.GetQuality()
+	b.Quality = types.StringValue(quality.Quality.GetName())
+
+	languages := make([]string, len(blocklist.GetLanguages()))
+	for i, l := range blocklist.GetLanguages() {
+		languages[i] = l.GetName()
+	}
+
+	b.Languages, tempDiag = types.SetValueFrom(ctx, types.StringType, languages)
+	diags.Append(tempDiag...)
+
+	formats := make([]string, len(blocklist.GetCustomFormats()))
+	for i, c := range blocklist.GetCustomFormats() {
+		formats[i] = c.GetName()
+	}
+
+	b.CustomFormats, tempDiag = types.SetValueFrom(ctx, types.StringType, formats)
+	diags.Append(tempDiag...)
+}
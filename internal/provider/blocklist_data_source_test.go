@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBlocklistDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccBlocklistDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccBlocklistDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.radarr_blocklist.test", "id"),
+					resource.TestCheckResourceAttr("data.radarr_blocklist.movie", "items.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccBlocklistDataSourceConfig = `
+data "radarr_blocklist" "test" {
+}
+
+data "radarr_blocklist" "movie" {
+	movie_ids = [999999]
+}
+`
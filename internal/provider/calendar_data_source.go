@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	calendarDataSourceName = "calendar"
+	calendarDefaultDays    = 2
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CalendarDataSource{}
+
+func NewCalendarDataSource() datasource.DataSource {
+	return &CalendarDataSource{}
+}
+
+// CalendarDataSource defines the calendar implementation.
+type CalendarDataSource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// Calendar describes the calendar data model.
+type Calendar struct {
+	Movies      types.Set    `tfsdk:"movies"`
+	Tags        types.Set    `tfsdk:"tags"`
+	ID          types.String `tfsdk:"id"`
+	Start       types.String `tfsdk:"start"`
+	End         types.String `tfsdk:"end"`
+	Unmonitored types.Bool   `tfsdk:"unmonitored"`
+}
+
+// CalendarMovie is part of Calendar.
+type CalendarMovie struct {
+	Title           types.String `tfsdk:"title"`
+	InCinemas       types.String `tfsdk:"in_cinemas"`
+	DigitalRelease  types.String `tfsdk:"digital_release"`
+	PhysicalRelease types.String `tfsdk:"physical_release"`
+	ID              types.Int64  `tfsdk:"id"`
+	TMDBID          types.Int64  `tfsdk:"tmdb_id"`
+	Year            types.Int64  `tfsdk:"year"`
+	Monitored       types.Bool   `tfsdk:"monitored"`
+	HasFile         types.Bool   `tfsdk:"has_file"`
+}
+
+func (c CalendarMovie) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"title":            types.StringType,
+			"in_cinemas":       types.StringType,
+			"digital_release":  types.StringType,
+			"physical_release": types.StringType,
+			"id":               types.Int64Type,
+			"tmdb_id":          types.Int64Type,
+			"year":             types.Int64Type,
+			"monitored":        types.BoolType,
+			"has_file":         types.BoolType,
+		})
+}
+
+func (d *CalendarDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + calendarDataSourceName
+}
+
+func (d *CalendarDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nList the [Movies](../resources/movie) with a release in the given window.\nFor more information refer to [Calendar](https://wiki.servarr.com/radarr/calendar) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "Window start, in RFC3339 format. Defaults to today.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "Window end, in RFC3339 format. Defaults to two days after `start`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"unmonitored": schema.BoolAttribute{
+				MarkdownDescription: "Include unmonitored movies.",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Only include movies with any of these tags.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"movies": schema.SetNestedAttribute{
+				MarkdownDescription: "Movie list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Movie ID.",
+							Computed:            true,
+						},
+						"tmdb_id": schema.Int64Attribute{
+							MarkdownDescription: "TMDB ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Movie title.",
+							Computed:            true,
+						},
+						"year": schema.Int64Attribute{
+							MarkdownDescription: "Year.",
+							Computed:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag.",
+							Computed:            true,
+						},
+						"has_file": schema.BoolAttribute{
+							MarkdownDescription: "Has file flag.",
+							Computed:            true,
+						},
+						"in_cinemas": schema.StringAttribute{
+							MarkdownDescription: "In cinemas release date, in RFC3339 format. Null if outside the window.",
+							Computed:            true,
+						},
+						"digital_release": schema.StringAttribute{
+							MarkdownDescription: "Digital release date, in RFC3339 format. Null if outside the window.",
+							Computed:            true,
+						},
+						"physical_release": schema.StringAttribute{
+							MarkdownDescription: "Physical release date, in RFC3339 format. Null if outside the window.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CalendarDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *CalendarDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Calendar
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	start, end := data.window(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags []int64
+
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, true)...)
+
+	tagIDs := make([]string, len(tags))
+	for i, t := range tags {
+		tagIDs[i] = strconv.FormatInt(t, 10)
+	}
+
+	// Get calendar current value
+	response, _, err := d.client.CalendarAPI.ListCalendar(d.auth).Start(start).End(end).Unmonitored(data.Unmonitored.ValueBool()).Tags(strings.Join(tagIDs, ",")).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, calendarDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+calendarDataSourceName)
+	// Map response body to resource schema attribute
+	movies := make([]CalendarMovie, len(response))
+	for i, m := range response {
+		movies[i].write(&m, start, end)
+	}
+
+	var diags diag.Diagnostics
+
+	data.Movies, diags = types.SetValueFrom(ctx, CalendarMovie{}.getType(), movies)
+	resp.Diagnostics.Append(diags...)
+
+	// Keep the configured values as written, only the defaults are computed
+	if data.Start.IsNull() || data.Start.IsUnknown() {
+		data.Start = types.StringValue(start.Format(time.RFC3339))
+	}
+
+	if data.End.IsNull() || data.End.IsUnknown() {
+		data.End = types.StringValue(end.Format(time.RFC3339))
+	}
+
+	data.ID = types.StringValue(strconv.Itoa(len(movies)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// window returns the calendar window, defaulting like the Radarr calendar endpoint.
+func (c *Calendar) window(diags *diag.Diagnostics) (time.Time, time.Time) {
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	if !c.Start.IsNull() {
+		var err error
+
+		start, err = time.Parse(time.RFC3339, c.Start.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("start"), helpers.DataSourceError, "Invalid RFC3339 date: "+err.Error())
+		}
+	}
+
+	end := start.AddDate(0, 0, calendarDefaultDays)
+
+	if !c.End.IsNull() {
+		var err error
+
+		end, err = time.Parse(time.RFC3339, c.End.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("end"), helpers.DataSourceError, "Invalid RFC3339 date: "+err.Error())
+		}
+	}
+
+	return start, end
+}
+
+func (c *CalendarMovie) write(movie *radarr.MovieResource, start, end time.Time) {
+	c.ID = types.Int64Value(int64(movie.GetId()))
+	c.TMDBID = types.Int64Value(int64(movie.GetTmdbId()))
+	c.Title = types.StringValue(movie.GetTitle())
+	c.Year = types.Int64Value(int64(movie.GetYear()))
+	c.Monitored = types.BoolValue(movie.GetMonitored())
+	c.HasFile = types.BoolValue(movie.GetHasFile())
+	c.InCinemas = calendarTimeValue(movie.InCinemas.Get(), start, end)
+	c.DigitalRelease = calendarTimeValue(movie.DigitalRelease.Get(), start, end)
+	c.PhysicalRelease = calendarTimeValue(movie.PhysicalRelease.Get(), start, end)
+}
+
+// calendarTimeValue returns the release date only if it falls in the window.
+func calendarTimeValue(t *time.Time, start, end time.Time) types.String {
+	if t == nil || t.Before(start) || t.After(end) {
+		return types.StringNull()
+	}
+
+	return helpers.TimeValue(t)
+}
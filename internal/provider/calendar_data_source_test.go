@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCalendarDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccMovieResourceConfig("Back to the Future", "BackFuture", 105) + testAccCalendarDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid date
+			{
+				Config:      `data "radarr_calendar" "test" { start = "today" }`,
+				ExpectError: regexp.MustCompile("Invalid RFC3339 date"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccMovieResourceConfig("Back to the Future", "BackFuture", 105) + testAccCalendarDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.radarr_calendar.test", "movies.#", "1"),
+					resource.TestCheckResourceAttr("data.radarr_calendar.test", "movies.0.tmdb_id", "105"),
+					resource.TestCheckResourceAttrSet("data.radarr_calendar.test", "movies.0.in_cinemas"),
+					resource.TestCheckResourceAttr("data.radarr_calendar.test", "end", "1985-08-01T00:00:00+00:00"),
+					resource.TestCheckResourceAttrSet("data.radarr_calendar.default", "end"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCalendarDataSourceConfig = `
+data "radarr_calendar" "default" {
+}
+
+data "radarr_calendar" "test" {
+	start       = "1985-06-01T00:00:00Z"
+	end         = "1985-08-01T00:00:00+00:00"
+	unmonitored = true
+	depends_on  = [radarr_movie.test]
+}
+`
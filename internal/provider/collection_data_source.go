@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const collectionDataSourceName = "collection"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CollectionDataSource{}
+
+func NewCollectionDataSource() datasource.DataSource {
+	return &CollectionDataSource{}
+}
+
+// CollectionDataSource defines the collection implementation.
+type CollectionDataSource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+func (d *CollectionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + collectionDataSourceName
+}
+
+func (d *CollectionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nSingle [Collection](../resources/collection).",
+		Attributes: map[string]schema.Attribute{
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Collection title.",
+				Required:            true,
+			},
+			"tmdb_id": schema.Int64Attribute{
+				MarkdownDescription: "Collection TMDB ID.",
+				Computed:            true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag.",
+				Computed:            true,
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile ID used for the added movies.",
+				Computed:            true,
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Root folder path used for the added movies.",
+				Computed:            true,
+			},
+			"minimum_availability": schema.StringAttribute{
+				MarkdownDescription: "Minimum availability used for the added movies.",
+				Computed:            true,
+			},
+			"search_on_add": schema.BoolAttribute{
+				MarkdownDescription: "Search on add flag.",
+				Computed:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Collection ID.",
+				Computed:            true,
+			},
+			"overview": schema.StringAttribute{
+				MarkdownDescription: "Overview.",
+				Computed:            true,
+			},
+			"missing_movies": schema.Int64Attribute{
+				MarkdownDescription: "Number of collection movies missing from the library.",
+				Computed:            true,
+			},
+			"movies": schema.SetNestedAttribute{
+				MarkdownDescription: "Collection movies.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tmdb_id": schema.Int64Attribute{
+							MarkdownDescription: "TMDB ID.",
+							Computed:            true,
+						},
+						"imdb_id": schema.StringAttribute{
+							MarkdownDescription: "IMDB ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Movie title.",
+							Computed:            true,
+						},
+						"year": schema.Int64Attribute{
+							MarkdownDescription: "Year.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Movie status.",
+							Computed:            true,
+						},
+						"is_existing": schema.BoolAttribute{
+							MarkdownDescription: "Movie already in library flag.",
+							Computed:            true,
+						},
+						"is_excluded": schema.BoolAttribute{
+							MarkdownDescription: "Movie in import list exclusions flag.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CollectionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *CollectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Collection
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Get collections current value
+	response, _, err := d.client.CollectionAPI.ListCollection(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, collectionDataSourceName, err))
+
+		return
+	}
+
+	data.find(ctx, data.Title.ValueString(), response, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "read "+collectionDataSourceName)
+	// Map response body to resource schema attribute
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (c *Collection) find(ctx context.Context, title string, collections []radarr.CollectionResource, diags *diag.Diagnostics) {
+	for _, collection := range collections {
+		if collection.GetTitle() == title {
+			c.write(ctx, &collection, diags)
+
+			return
+		}
+	}
+
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(collectionDataSourceName, "title", title))
+}
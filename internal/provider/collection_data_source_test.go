@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccCollectionDataSourceConfig("\"Error\"") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      testAccCollectionDataSourceConfig("\"Error\""),
+				ExpectError: regexp.MustCompile("Unable to find collection"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccMovieResourceConfig("The Hobbit: An Unexpected Journey", "Hobbit", 49051) + testAccCollectionDataSourceConfig("radarr_movie.test.collection.title"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.radarr_collection.test", "title", "The Hobbit Collection"),
+					resource.TestCheckResourceAttr("data.radarr_collection.test", "tmdb_id", "121938"),
+					resource.TestCheckResourceAttrSet("data.radarr_collection.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCollectionDataSourceConfig(title string) string {
+	return `
+	data "radarr_collection" "test" {
+		title = ` + title + `
+	}
+	`
+}
@@ -0,0 +1,380 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const collectionResourceName = "collection"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &CollectionResource{}
+	_ resource.ResourceWithImportState = &CollectionResource{}
+)
+
+func NewCollectionResource() resource.Resource {
+	return &CollectionResource{}
+}
+
+// CollectionResource defines the collection implementation.
+type CollectionResource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// Collection describes the collection data model.
+type Collection struct {
+	Tags                types.Set    `tfsdk:"tags"`
+	Movies              types.Set    `tfsdk:"movies"`
+	Title               types.String `tfsdk:"title"`
+	Overview            types.String `tfsdk:"overview"`
+	RootFolderPath      types.String `tfsdk:"root_folder_path"`
+	MinimumAvailability types.String `tfsdk:"minimum_availability"`
+	ID                  types.Int64  `tfsdk:"id"`
+	TMDBID              types.Int64  `tfsdk:"tmdb_id"`
+	QualityProfileID    types.Int64  `tfsdk:"quality_profile_id"`
+	MissingMovies       types.Int64  `tfsdk:"missing_movies"`
+	Monitored           types.Bool   `tfsdk:"monitored"`
+	SearchOnAdd         types.Bool   `tfsdk:"search_on_add"`
+}
+
+func (c Collection) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"tags":                 types.SetType{}.WithElementType(types.Int64Type),
+			"movies":               types.SetType{}.WithElementType(CollectionMovie{}.getType()),
+			"title":                types.StringType,
+			"overview":             types.StringType,
+			"root_folder_path":     types.StringType,
+			"minimum_availability": types.StringType,
+			"id":                   types.Int64Type,
+			"tmdb_id":              types.Int64Type,
+			"quality_profile_id":   types.Int64Type,
+			"missing_movies":       types.Int64Type,
+			"monitored":            types.BoolType,
+			"search_on_add":        types.BoolType,
+		})
+}
+
+// CollectionMovie is part of Collection.
+type CollectionMovie struct {
+	Title      types.String `tfsdk:"title"`
+	IMDBID     types.String `tfsdk:"imdb_id"`
+	Status     types.String `tfsdk:"status"`
+	TMDBID     types.Int64  `tfsdk:"tmdb_id"`
+	Year       types.Int64  `tfsdk:"year"`
+	IsExisting types.Bool   `tfsdk:"is_existing"`
+	IsExcluded types.Bool   `tfsdk:"is_excluded"`
+}
+
+func (m CollectionMovie) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"title":       types.StringType,
+			"imdb_id":     types.StringType,
+			"status":      types.StringType,
+			"tmdb_id":     types.Int64Type,
+			"year":        types.Int64Type,
+			"is_existing": types.BoolType,
+			"is_excluded": types.BoolType,
+		})
+}
+
+func (r *CollectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + collectionResourceName
+}
+
+func (r *CollectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Movies -->\nCollection resource.\nCollections are created by Radarr as soon as one of their movies is added, this resource manages the settings of an existing collection.\nFor more information refer to [Collections](https://wiki.servarr.com/radarr/library#collections) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"tmdb_id": schema.Int64Attribute{
+				MarkdownDescription: "Collection TMDB ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag. When monitored, the missing movies of the collection are added to Radarr.",
+				Required:            true,
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile ID used for the added movies.",
+				Required:            true,
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Root folder path used for the added movies.",
+				Required:            true,
+			},
+			"minimum_availability": schema.StringAttribute{
+				MarkdownDescription: "Minimum availability used for the added movies.\nAllowed values: 'tba', 'announced', 'inCinemas', 'released', 'deleted'.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("tba", "announced", "inCinemas", "released", "deleted"),
+				},
+			},
+			"search_on_add": schema.BoolAttribute{
+				MarkdownDescription: "Search on add flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Collection ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Collection title.",
+				Computed:            true,
+			},
+			"overview": schema.StringAttribute{
+				MarkdownDescription: "Overview.",
+				Computed:            true,
+			},
+			"missing_movies": schema.Int64Attribute{
+				MarkdownDescription: "Number of collection movies missing from the library.",
+				Computed:            true,
+			},
+			"movies": schema.SetNestedAttribute{
+				MarkdownDescription: "Collection movies.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: r.getCollectionMovieSchema().Attributes,
+				},
+			},
+		},
+	}
+}
+
+func (r CollectionResource) getCollectionMovieSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tmdb_id": schema.Int64Attribute{
+				MarkdownDescription: "TMDB ID.",
+				Computed:            true,
+			},
+			"imdb_id": schema.StringAttribute{
+				MarkdownDescription: "IMDB ID.",
+				Computed:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Movie title.",
+				Computed:            true,
+			},
+			"year": schema.Int64Attribute{
+				MarkdownDescription: "Year.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Movie status.",
+				Computed:            true,
+			},
+			"is_existing": schema.BoolAttribute{
+				MarkdownDescription: "Movie already in library flag.",
+				Computed:            true,
+			},
+			"is_excluded": schema.BoolAttribute{
+				MarkdownDescription: "Movie in import list exclusions flag.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *CollectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var collection *Collection
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &collection)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Collection cannot be created, find the existing one by TMDB ID
+	collections, _, err := r.client.CollectionAPI.ListCollection(r.auth).TmdbId(int32(collection.TMDBID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, collectionResourceName, err))
+
+		return
+	}
+
+	if len(collections) == 0 {
+		resp.Diagnostics.AddError(helpers.ResourceError, helpers.ParseNotFoundError(collectionResourceName, "TMDB ID", strconv.Itoa(int(collection.TMDBID.ValueInt64()))))
+
+		return
+	}
+
+	// Build Create resource
+	request := collection.read(ctx, &collections[0], &resp.Diagnostics)
+
+	response, _, err := r.client.CollectionAPI.UpdateCollection(r.auth, strconv.Itoa(int(request.GetId()))).CollectionResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, collectionResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+collectionResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	collection.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &collection)...)
+}
+
+func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var collection *Collection
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &collection)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get collection current value
+	response, _, err := r.client.CollectionAPI.GetCollectionById(r.auth, int32(collection.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, collectionResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+collectionResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	collection.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &collection)...)
+}
+
+func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var collection *Collection
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &collection)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get collection current value to preserve the read only fields
+	current, _, err := r.client.CollectionAPI.GetCollectionById(r.auth, int32(collection.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, collectionResourceName, err))
+
+		return
+	}
+
+	// Update Collection
+	request := collection.read(ctx, current, &resp.Diagnostics)
+
+	response, _, err := r.client.CollectionAPI.UpdateCollection(r.auth, strconv.Itoa(int(request.GetId()))).CollectionResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, collectionResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+collectionResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	collection.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &collection)...)
+}
+
+func (r *CollectionResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Collection cannot be really deleted just removing configuration
+	tflog.Trace(ctx, "decoupled "+collectionResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *CollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	tflog.Trace(ctx, "imported "+collectionResourceName+": "+req.ID)
+}
+
+func (c *Collection) write(ctx context.Context, collection *radarr.CollectionResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	c.ID = types.Int64Value(int64(collection.GetId()))
+	c.TMDBID = types.Int64Value(int64(collection.GetTmdbId()))
+	c.Title = types.StringValue(collection.GetTitle())
+	c.Overview = types.StringValue(collection.GetOverview())
+	c.Monitored = types.BoolValue(collection.GetMonitored())
+	c.QualityProfileID = types.Int64Value(int64(collection.GetQualityProfileId()))
+	c.RootFolderPath = types.StringValue(collection.GetRootFolderPath())
+	c.MinimumAvailability = types.StringValue(string(collection.GetMinimumAvailability()))
+	c.SearchOnAdd = types.BoolValue(collection.GetSearchOnAdd())
+	c.MissingMovies = types.Int64Value(int64(collection.GetMissingMovies()))
+	c.Tags, tempDiag = types.SetValueFrom(ctx, types.Int64Type, collection.GetTags())
+	diags.Append(tempDiag...)
+
+	movies := make([]CollectionMovie, len(collection.GetMovies()))
+	for i, m := range collection.GetMovies() {
+		movies[i].write(&m)
+	}
+
+	c.Movies, tempDiag = types.SetValueFrom(ctx, CollectionMovie{}.getType(), movies)
+	diags.Append(tempDiag...)
+}
+
+func (m *CollectionMovie) write(movie *radarr.CollectionMovieResource) {
+	m.TMDBID = types.Int64Value(int64(movie.GetTmdbId()))
+	m.IMDBID = types.StringValue(movie.GetImdbId())
+	m.Title = types.StringValue(movie.GetTitle())
+	m.Year = types.Int64Value(int64(movie.GetYear()))
+	m.Status = types.StringValue(string(movie.GetStatus()))
+	m.IsExisting = types.BoolValue(movie.GetIsExisting())
+	m.IsExcluded = types.BoolValue(movie.GetIsExcluded())
+}
+
+// read applies the planned settings on top of the current collection.
+func (c *Collection) read(ctx context.Context, current *radarr.CollectionResource, diags *diag.Diagnostics) *radarr.CollectionResource {
+	collection := *current
+	collection.SetMonitored(c.Monitored.ValueBool())
+	collection.SetQualityProfileId(int32(c.QualityProfileID.ValueInt64()))
+	collection.SetRootFolderPath(c.RootFolderPath.ValueString())
+
+	if !c.MinimumAvailability.IsNull() && !c.MinimumAvailability.IsUnknown() {
+		collection.SetMinimumAvailability(radarr.MovieStatusType(c.MinimumAvailability.ValueString()))
+	}
+
+	if !c.SearchOnAdd.IsNull() && !c.SearchOnAdd.IsUnknown() {
+		collection.SetSearchOnAdd(c.SearchOnAdd.ValueBool())
+	}
+
+	if !c.Tags.IsNull() && !c.Tags.IsUnknown() {
+		diags.Append(c.Tags.ElementsAs(ctx, &collection.Tags, true)...)
+	}
+
+	return &collection
+}
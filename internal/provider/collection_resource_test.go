@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccCollectionResourceConfig("released") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccCollectionResourceConfig("released"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_collection.test", "title", "The Lord of the Rings Collection"),
+					resource.TestCheckResourceAttr("radarr_collection.test", "minimum_availability", "released"),
+					resource.TestCheckResourceAttrSet("radarr_collection.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("radarr_collection.test", "movies.*", map[string]string{"tmdb_id": "120", "is_existing": "true"}),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccCollectionResourceConfig("released") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccCollectionResourceConfig("inCinemas"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_collection.test", "minimum_availability", "inCinemas"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "radarr_collection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCollectionResourceConfig(availability string) string {
+	return testAccMovieResourceConfig("The Lord of the Rings: The Fellowship of the Ring", "Fellowship", 120) + fmt.Sprintf(`
+		resource "radarr_collection" "test" {
+			tmdb_id = radarr_movie.test.collection.tmdb_id
+			monitored = false
+			quality_profile_id = 1
+			root_folder_path = "/config"
+			minimum_availability = "%s"
+			search_on_add = false
+		}
+	`, availability)
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const collectionsDataSourceName = "collections"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CollectionsDataSource{}
+
+func NewCollectionsDataSource() datasource.DataSource {
+	return &CollectionsDataSource{}
+}
+
+// CollectionsDataSource defines the collections implementation.
+type CollectionsDataSource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// Collections describes the collections data model.
+type Collections struct {
+	Collections types.Set    `tfsdk:"collections"`
+	ID          types.String `tfsdk:"id"`
+}
+
+func (d *CollectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + collectionsDataSourceName
+}
+
+func (d *CollectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nList all available [Collections](../resources/collection).",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"collections": schema.SetNestedAttribute{
+				MarkdownDescription: "Collection list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Collection title.",
+							Computed:            true,
+						},
+						"tmdb_id": schema.Int64Attribute{
+							MarkdownDescription: "Collection TMDB ID.",
+							Computed:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag.",
+							Computed:            true,
+						},
+						"quality_profile_id": schema.Int64Attribute{
+							MarkdownDescription: "Quality profile ID used for the added movies.",
+							Computed:            true,
+						},
+						"root_folder_path": schema.StringAttribute{
+							MarkdownDescription: "Root folder path used for the added movies.",
+							Computed:            true,
+						},
+						"minimum_availability": schema.StringAttribute{
+							MarkdownDescription: "Minimum availability used for the added movies.",
+							Computed:            true,
+						},
+						"search_on_add": schema.BoolAttribute{
+							MarkdownDescription: "Search on add flag.",
+							Computed:            true,
+						},
+						"tags": schema.SetAttribute{
+							MarkdownDescription: "List of associated tags.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Collection ID.",
+							Computed:            true,
+						},
+						"overview": schema.StringAttribute{
+							MarkdownDescription: "Overview.",
+							Computed:            true,
+						},
+						"missing_movies": schema.Int64Attribute{
+							MarkdownDescription: "Number of collection movies missing from the library.",
+							Computed:            true,
+						},
+						"movies": schema.SetNestedAttribute{
+							MarkdownDescription: "Collection movies.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"tmdb_id": schema.Int64Attribute{
+										MarkdownDescription: "TMDB ID.",
+										Computed:            true,
+									},
+									"imdb_id": schema.StringAttribute{
+										MarkdownDescription: "IMDB ID.",
+										Computed:            true,
+									},
+									"title": schema.StringAttribute{
+										MarkdownDescription: "Movie title.",
+										Computed:            true,
+									},
+									"year": schema.Int64Attribute{
+										MarkdownDescription: "Year.",
+										Computed:            true,
+									},
+									"status": schema.StringAttribute{
+										MarkdownDescription: "Movie status.",
+										Computed:            true,
+									},
+									"is_existing": schema.BoolAttribute{
+										MarkdownDescription: "Movie already in library flag.",
+										Computed:            true,
+									},
+									"is_excluded": schema.BoolAttribute{
+										MarkdownDescription: "Movie in import list exclusions flag.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CollectionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *CollectionsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get collections current value
+	response, _, err := d.client.CollectionAPI.ListCollection(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, collectionsDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+collectionsDataSourceName)
+	// Map response body to resource schema attribute
+	collections := make([]Collection, len(response))
+	for i, c := range response {
+		collections[i].write(ctx, &c, &resp.Diagnostics)
+	}
+
+	collectionList, diags := types.SetValueFrom(ctx, Collection{}.getType(), collections)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, Collections{Collections: collectionList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCollectionsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccMovieResourceConfig("Harry Potter and the Philosopher's Stone", "Potter", 671) + testAccCollectionsDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccMovieResourceConfig("Harry Potter and the Philosopher's Stone", "Potter", 671) + testAccCollectionsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.radarr_collections.test", "collections.*", map[string]string{"title": "Harry Potter Collection"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccCollectionsDataSourceConfig = `
+data "radarr_collections" "test" {
+	depends_on = [radarr_movie.test]
+}
+`
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCommandResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccCommandResourceConfig("first") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid body
+			{
+				Config:      testAccCommandResourceBodyConfig("[1]"),
+				ExpectError: regexp.MustCompile("Unable to parse body"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccCommandResourceConfig("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_command.test", "status", "completed"),
+					resource.TestCheckResourceAttr("radarr_command.test", "timeout", "600"),
+					resource.TestCheckResourceAttrSet("radarr_command.test", "id"),
+					resource.TestCheckResourceAttrSet("radarr_command.test", "ended"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccCommandResourceConfig("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_command.test", "triggers.run", "second"),
+					resource.TestCheckResourceAttr("radarr_command.test", "status", "completed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCommandResourceConfig(trigger string) string {
+	return fmt.Sprintf(`
+		resource "radarr_command" "test" {
+			name = "RefreshMonitoredDownloads"
+			triggers = {
+				run = "%s"
+			}
+		}
+	`, trigger)
+}
+
+func testAccCommandResourceBodyConfig(body string) string {
+	return fmt.Sprintf(`
+		resource "radarr_command" "test" {
+			name = "RefreshMovie"
+			body = jsonencode(%s)
+		}
+	`, body)
+}
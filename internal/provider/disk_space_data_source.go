@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const diskSpaceDataSourceName = "disk_space"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DiskSpaceDataSource{}
+
+func NewDiskSpaceDataSource() datasource.DataSource {
+	return &DiskSpaceDataSource{}
+}
+
+// DiskSpaceDataSource defines the disk space implementation.
+type DiskSpaceDataSource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// DiskSpaces describes the disk spaces data model.
+type DiskSpaces struct {
+	DiskSpaces types.Set    `tfsdk:"disk_spaces"`
+	ID         types.String `tfsdk:"id"`
+}
+
+// DiskSpace is part of DiskSpaces.
+type DiskSpace struct {
+	Path       types.String `tfsdk:"path"`
+	Label      types.String `tfsdk:"label"`
+	FreeSpace  types.Int64  `tfsdk:"free_space"`
+	TotalSpace types.Int64  `tfsdk:"total_space"`
+}
+
+func (d DiskSpace) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"path":        types.StringType,
+			"label":       types.StringType,
+			"free_space":  types.Int64Type,
+			"total_space": types.Int64Type,
+		})
+}
+
+func (d *DiskSpaceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + diskSpaceDataSourceName
+}
+
+func (d *DiskSpaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->\nList the disk space of all the mounts seen by Radarr.\nFor more information refer to [Disk Space](https://wiki.servarr.com/radarr/system#disk-space) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"disk_spaces": schema.SetNestedAttribute{
+				MarkdownDescription: "Disk space list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Mount path.",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "Label.",
+							Computed:            true,
+						},
+						"free_space": schema.Int64Attribute{
+							MarkdownDescription: "Free space in bytes.",
+							Computed:            true,
+						},
+						"total_space": schema.Int64Attribute{
+							MarkdownDescription: "Total space in bytes.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DiskSpaceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *DiskSpaceDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get disk space current value
+	response, _, err := d.client.DiskSpaceAPI.ListDiskSpace(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, diskSpaceDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+diskSpaceDataSourceName)
+	// Map response body to resource schema attribute
+	disks := make([]DiskSpace, len(response))
+	for i, s := range response {
+		disks[i].write(&s)
+	}
+
+	diskList, diags := types.SetValueFrom(ctx, DiskSpace{}.getType(), disks)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, DiskSpaces{DiskSpaces: diskList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
+
+func (d *DiskSpace) write(disk *radarr.DiskSpaceResource) {
+	d.Path = types.StringValue(disk.GetPath())
+	d.Label = types.StringValue(disk.GetLabel())
+	d.FreeSpace = types.Int64Value(disk.GetFreeSpace())
+	d.TotalSpace = types.Int64Value(disk.GetTotalSpace())
+}
+
+// diskTotalSpace returns the total space of the mount containing the path, null if none matches.
+func diskTotalSpace(path string, disks []radarr.DiskSpaceResource) types.Int64 {
+	total := types.Int64Null()
+	longest := -1
+	path = diskPath(path)
+
+	for _, d := range disks {
+		mount := diskPath(d.GetPath())
+		if (path == mount || strings.HasPrefix(path, mount+"/")) && len(mount) > longest {
+			longest = len(mount)
+			total = types.Int64Value(d.GetTotalSpace())
+		}
+	}
+
+	return total
+}
+
+// diskPath normalizes the path separators, Windows paths are also compared case insensitively.
+func diskPath(path string) string {
+	if strings.Contains(path, "\\") || (len(path) > 1 && path[1] == ':') {
+		path = strings.ToLower(strings.ReplaceAll(path, "\\", "/"))
+	}
+
+	return strings.TrimSuffix(path, "/")
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDiskSpaceDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccDiskSpaceDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccDiskSpaceDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.radarr_disk_space.test", "disk_spaces.0.path"),
+					resource.TestCheckResourceAttrSet("data.radarr_disk_space.test", "disk_spaces.0.total_space"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDiskSpaceDataSourceConfig = `
+data "radarr_disk_space" "test" {
+}
+`
+
+func TestDiskTotalSpace(t *testing.T) {
+	t.Parallel()
+
+	disk := func(path string, total int64) radarr.DiskSpaceResource {
+		d := radarr.NewDiskSpaceResource()
+		d.SetPath(path)
+		d.SetTotalSpace(total)
+
+		return *d
+	}
+
+	tests := map[string]struct {
+		path     string
+		disks    []radarr.DiskSpaceResource
+		expected types.Int64
+	}{
+		"linux": {
+			path:     "/movies/library",
+			disks:    []radarr.DiskSpaceResource{disk("/", 1), disk("/movies", 2), disk("/mov", 3)},
+			expected: types.Int64Value(2),
+		},
+		"linux exact": {
+			path:     "/movies",
+			disks:    []radarr.DiskSpaceResource{disk("/", 1), disk("/movies/", 2)},
+			expected: types.Int64Value(2),
+		},
+		"windows": {
+			path:     "D:\\Movies\\Library",
+			disks:    []radarr.DiskSpaceResource{disk("C:\\", 1), disk("d:\\", 2)},
+			expected: types.Int64Value(2),
+		},
+		"windows unc": {
+			path:     "\\\\nas\\Movies",
+			disks:    []radarr.DiskSpaceResource{disk("C:\\", 1), disk("\\\\NAS\\movies", 2)},
+			expected: types.Int64Value(2),
+		},
+		"no match": {
+			path:     "E:\\Movies",
+			disks:    []radarr.DiskSpaceResource{disk("C:\\", 1)},
+			expected: types.Int64Null(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, diskTotalSpace(test.path, test.disks))
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	historyDataSourceName = "history"
+	historyPageSize       = 100
+)
+
+// historyEventTypeIDs maps the event types to the values expected by the history filter.
+var historyEventTypeIDs = map[string]int32{
+	string(radarr.MOVIEHISTORYEVENTTYPE_UNKNOWN):                  0,
+	string(radarr.MOVIEHISTORYEVENTTYPE_GRABBED):                  1,
+	string(radarr.MOVIEHISTORYEVENTTYPE_DOWNLOAD_FOLDER_IMPORTED): 3,
+	string(radarr.MOVIEHISTORYEVENTTYPE_DOWNLOAD_FAILED):          4,
+	string(radarr.MOVIEHISTORYEVENTTYPE_MOVIE_FILE_DELETED):       6,
+	string(radarr.MOVIEHISTORYEVENTTYPE_MOVIE_FOLDER_IMPORTED):    7,
+	string(radarr.MOVIEHISTORYEVENTTYPE_MOVIE_FILE_RENAMED):       8,
+	string(radarr.MOVIEHISTORYEVENTTYPE_DOWNLOAD_IGNORED):         9,
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HistoryDataSource{}
+
+func NewHistoryDataSource() datasource.DataSource {
+	return &HistoryDataSource{}
+}
+
+// HistoryDataSource defines the history implementation.
+type HistoryDataSource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// History describes the history data model.
+type History struct {
+	Items     types.Set    `tfsdk:"items"`
+	ID        types.String `tfsdk:"id"`
+	EventType types.String `tfsdk:"event_type"`
+	Since     types.String `tfsdk:"since"`
+	MovieID   types.Int64  `tfsdk:"movie_id"`
+}
+
+// HistoryItem is part of History.
+type HistoryItem struct {
+	Data              types.Map    `tfsdk:"data"`
+	Languages         types.Set    `tfsdk:"languages"`
+	CustomFormats     types.Set    `tfsdk:"custom_formats"`
+	EventType         types.String `tfsdk:"event_type"`
+	SourceTitle       types.String `tfsdk:"source_title"`
+	Quality           types.String `tfsdk:"quality"`
+	Date              types.String `tfsdk:"date"`
+	DownloadID        types.String `tfsdk:"download_id"`
+	ID                types.Int64  `tfsdk:"id"`
+	MovieID           types.Int64  `tfsdk:"movie_id"`
+	CustomFormatScore types.Int64  `tfsdk:"custom_format_score"`
+}
+
+func (h HistoryItem) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"data":                types.MapType{}.WithElementType(types.StringType),
+			"languages":           types.SetType{}.WithElementType(types.StringType),
+			"custom_formats":      types.SetType{}.WithElementType(types.StringType),
+			"event_type":          types.StringType,
+			"source_title":        types.StringType,
+			"quality":             types.StringType,
+			"date":                types.StringType,
+			"download_id":         types.StringType,
+			"id":                  types.Int64Type,
+			"movie_id":            types.Int64Type,
+			"custom_format_score": types.Int64Type,
+		})
+}
+
+func (d *HistoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + historyDataSourceName
+}
+
+func (d *HistoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Activity -->\nList the history events.\nOptional filters are combined, only events matching all of them are returned.\nFor more information refer to [History](https://wiki.servarr.com/radarr/activity#history) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Filter by movie ID.",
+				Optional:            true,
+			},
+			"event_type": schema.StringAttribute{
+				MarkdownDescription: "Filter by event type.\nAllowed values: 'grabbed', 'downloadFolderImported', 'downloadFailed', 'movieFileDeleted', 'movieFolderImported', 'movieFileRenamed', 'downloadIgnored'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(radarr.MOVIEHISTORYEVENTTYPE_GRABBED),
+						string(radarr.MOVIEHISTORYEVENTTYPE_DOWNLOAD_FOLDER_IMPORTED),
+						string(radarr.MOVIEHISTORYEVENTTYPE_DOWNLOAD_FAILED),
+						string(radarr.MOVIEHISTORYEVENTTYPE_MOVIE_FILE_DELETED),
+						string(radarr.MOVIEHISTORYEVENTTYPE_MOVIE_FOLDER_IMPORTED),
+						string(radarr.MOVIEHISTORYEVENTTYPE_MOVIE_FILE_RENAMED),
+						string(radarr.MOVIEHISTORYEVENTTYPE_DOWNLOAD_IGNORED),
+					),
+				},
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Only return events after this date, in RFC3339 format.",
+				Optional:            true,
+			},
+			"items": schema.SetNestedAttribute{
+				MarkdownDescription: "History event list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "History event ID.",
+							Computed:            true,
+						},
+						"movie_id": schema.Int64Attribute{
+							MarkdownDescription: "Movie ID.",
+							Computed:            true,
+						},
+						"event_type": schema.StringAttribute{
+							MarkdownDescription: "Event type.",
+							Computed:            true,
+						},
+						"source_title": schema.StringAttribute{
+							MarkdownDescription: "Source release title.",
+							Computed:            true,
+						},
+						"quality": schema.StringAttribute{
+							MarkdownDescription: "Quality name.",
+							Computed:            true,
+						},
+						"languages": schema.SetAttribute{
+							MarkdownDescription: "Language names.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"custom_formats": schema.SetAttribute{
+							MarkdownDescription: "Matched custom format names.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"custom_format_score": schema.Int64Attribute{
+							MarkdownDescription: "Total custom format score.",
+							Computed:            true,
+						},
+						"date": schema.StringAttribute{
+							MarkdownDescription: "Event date, in RFC3339 format.",
+							Computed:            true,
+						},
+						"download_id": schema.StringAttribute{
+							MarkdownDescription: "Download ID in the download client.",
+							Computed:            true,
+						},
+						"data": schema.MapAttribute{
+							MarkdownDescription: "Event specific data, e.g. 'indexer', 'releaseGroup', 'droppedPath', 'importedPath', 'message'.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *HistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *History
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var since time.Time
+
+	if !data.Since.IsNull() {
+		var err error
+
+		since, err = time.Parse(time.RFC3339, data.Since.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("since"), helpers.DataSourceError, "Invalid RFC3339 date: "+err.Error())
+
+			return
+		}
+	}
+
+	// Get history current value
+	response, err := d.list(data.MovieID.ValueInt64Pointer(), data.EventType.ValueString(), since)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, historyDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+historyDataSourceName)
+	// Map filtered response body to resource schema attribute
+	items := make([]HistoryItem, 0, len(response))
+
+	// Event type is filtered server side too, check it in case the filter is ignored
+	for _, h := range response {
+		if data.EventType.IsNull() || data.EventType.ValueString() == string(h.GetEventType()) {
+			item := HistoryItem{}
+			item.write(ctx, &h, &resp.Diagnostics)
+			items = append(items, item)
+		}
+	}
+
+	var diags diag.Diagnostics
+
+	data.Items, diags = types.SetValueFrom(ctx, HistoryItem{}.getType(), items)
+	resp.Diagnostics.Append(diags...)
+
+	data.ID = types.StringValue(strconv.Itoa(len(items)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// list fetches the history pages, newest first, stopping at the first event older than since.
+func (d *HistoryDataSource) list(movieID *int64, eventType string, since time.Time) ([]radarr.HistoryResource, error) {
+	return helpers.ListPages(func(page int32) (*radarr.HistoryResourcePagingResource, *http.Response, error) {
+		request := d.client.HistoryAPI.GetHistory(d.auth).Page(page).PageSize(historyPageSize).SortKey("date").SortDirection(radarr.SORTDIRECTION_DESCENDING)
+		if movieID != nil {
+			request = request.MovieIds([]int32{int32(*movieID)})
+		}
+
+		if id, ok := historyEventTypeIDs[eventType]; ok {
+			request = request.EventType([]int32{id})
+		}
+
+		return request.Execute()
+	}, func(h *radarr.HistoryResource) bool {
+		return h.GetDate().Before(since)
+	})
+}
+
+func (h *HistoryItem) write(ctx context.Context, history *radarr.HistoryResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	h.ID = types.Int64Value(int64(history.GetId()))
+	h.MovieID = types.Int64Value(int64(history.GetMovieId()))
+	h.EventType = types.StringValue(string(history.GetEventType()))
+	h.SourceTitle = types.StringValue(history.GetSourceTitle())
+	h.Date = helpers.TimeValue(history.Date)
+	h.DownloadID = types.StringValue(history.GetDownloadId())
+	h.CustomFormatScore = types.Int64Value(int64(history.GetCustomFormatScore()))
+
+	quality := history.GetQuality()
+	h.Quality = types.StringValue(quality.Quality.GetName())
+
+	languages := make([]string, len(history.GetLanguages()))
+	for i, l := range history.GetLanguages() {
+		languages[i] = l.GetName()
+	}
+
+	h.Languages, tempDiag = types.SetValueFrom(ctx, types.StringType, languages)
+	diags.Append(tempDiag...)
+
+	formats := make([]string, len(history.GetCustomFormats()))
+	for i, c := range history.GetCustomFormats() {
+		formats[i] = c.GetName()
+	}
+
+	h.CustomFormats, tempDiag = types.SetValueFrom(ctx, types.StringType, formats)
+	diags.Append(tempDiag...)
+
+	h.Data, tempDiag = types.MapValueFrom(ctx, types.StringType, history.GetData())
+	diags.Append(tempDiag...)
+}
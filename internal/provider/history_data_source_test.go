@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHistoryDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccHistoryDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid date
+			{
+				Config:      `data "radarr_history" "test" { since = "yesterday" }`,
+				ExpectError: regexp.MustCompile("Invalid RFC3339 date"),
+			},
+			// Read testing
+			{
+				Config: testAccHistoryDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.radarr_history.test", "id"),
+					resource.TestCheckResourceAttr("data.radarr_history.grabbed", "event_type", "grabbed"),
+				),
+			},
+		},
+	})
+}
+
+const testAccHistoryDataSourceConfig = `
+data "radarr_history" "test" {
+}
+
+data "radarr_history" "grabbed" {
+	event_type = "grabbed"
+	since      = "2020-01-01T00:00:00Z"
+}
+`
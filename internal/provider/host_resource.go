@@ -2,7 +2,11 @@ package provider
 
 import (
 	"context"
+	"net"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/devopsarr/radarr-go/radarr"
 	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
@@ -23,12 +27,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const hostResourceName = "host"
+const (
+	hostResourceName       = "host"
+	hostRestartTimeout     = 5 * time.Minute
+	hostResetAPIKeyCommand = "ResetApiKey"
+)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &HostResource{}
 	_ resource.ResourceWithImportState = &HostResource{}
+	_ resource.ResourceWithModifyPlan  = &HostResource{}
 )
 
 func NewHostResource() resource.Resource {
@@ -39,6 +48,15 @@ func NewHostResource() resource.Resource {
 type HostResource struct {
 	client *radarr.APIClient
 	auth   context.Context
+	data   *RadarrData
+}
+
+// HostSettings describes the host resource data model.
+type HostSettings struct {
+	Host
+	RotateAPIKey    types.Map    `tfsdk:"rotate_api_key"`
+	APIKey          types.String `tfsdk:"api_key"`
+	RestartOnChange types.Bool   `tfsdk:"restart_on_change"`
 }
 
 // Host describes the host data model.
@@ -194,6 +212,25 @@ func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				MarkdownDescription: "TCP port.",
 				Required:            true,
 			},
+			"restart_on_change": schema.BoolAttribute{
+				MarkdownDescription: "Restart Radarr when `port`, `bind_address`, `url_base`, `ssl` or `authentication.method` change, waiting for it to answer on the new address.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "API key. The provider switches to the new key as soon as it is reset, update its `api_key` for the following runs.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotate_api_key": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that reset `api_key` through Radarr when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Host ID.",
 				Computed:            true,
@@ -406,12 +443,13 @@ func (r *HostResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.data, _ = req.ProviderData.(*RadarrData)
 	}
 }
 
 func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var host *Host
+	var host *HostSettings
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &host)...)
 
@@ -423,6 +461,18 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 	request := host.read(ctx, &resp.Diagnostics)
 	request.SetId(1)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current value to detect restart requiring changes
+	current, _, err := r.client.HostConfigAPI.GetHostConfig(r.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, hostResourceName, err))
+
+		return
+	}
+
 	// Create new Host
 	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(r.auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
@@ -432,14 +482,20 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	tflog.Trace(ctx, "created "+hostResourceName+": "+strconv.Itoa(int(response.GetId())))
+
+	if host.RestartOnChange.ValueBool() {
+		r.restart(ctx, current, response, helpers.Create, &resp.Diagnostics)
+	}
+
 	// Generate resource state struct
 	host.write(ctx, response, &resp.Diagnostics)
+	host.APIKey = types.StringValue(response.GetApiKey())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &host)...)
 }
 
 func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var host *Host
+	var host *HostSettings
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &host)...)
 
@@ -458,14 +514,16 @@ func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	tflog.Trace(ctx, "read "+hostResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	host.write(ctx, response, &resp.Diagnostics)
+	host.APIKey = types.StringValue(response.GetApiKey())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &host)...)
 }
 
 func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var host *Host
+	// Get plan and state values
+	var host, state *HostSettings
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &host)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -474,6 +532,18 @@ func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Build Update resource
 	request := host.read(ctx, &resp.Diagnostics)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current value to detect restart requiring changes
+	current, _, err := r.client.HostConfigAPI.GetHostConfig(r.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, hostResourceName, err))
+
+		return
+	}
+
 	// Update Host
 	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(r.auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
@@ -483,11 +553,42 @@ func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	tflog.Trace(ctx, "updated "+hostResourceName+": "+strconv.Itoa(int(response.GetId())))
+
+	if !host.RotateAPIKey.Equal(state.RotateAPIKey) {
+		if response = r.resetAPIKey(ctx, current, helpers.Update, &resp.Diagnostics); response == nil {
+			return
+		}
+	}
+
+	if host.RestartOnChange.ValueBool() {
+		r.restart(ctx, current, response, helpers.Update, &resp.Diagnostics)
+	}
+
 	// Generate resource state struct
 	host.write(ctx, response, &resp.Diagnostics)
+	host.APIKey = types.StringValue(response.GetApiKey())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &host)...)
 }
 
+func (r *HostResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var stateRotate, planRotate types.Map
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rotate_api_key"), &stateRotate)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rotate_api_key"), &planRotate)...)
+
+	if resp.Diagnostics.HasError() || planRotate.Equal(stateRotate) {
+		return
+	}
+
+	// Radarr will generate a new API key
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("api_key"), types.StringUnknown())...)
+}
+
 func (r *HostResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Host cannot be really deleted just removing configuration
 	tflog.Trace(ctx, "decoupled "+hostResourceName+": 1")
@@ -498,6 +599,144 @@ func (r *HostResource) ImportState(ctx context.Context, req resource.ImportState
 	tflog.Trace(ctx, "imported "+hostResourceName+": 1")
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), 1)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authentication").AtName("password"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restart_on_change"), false)...)
+}
+
+// restart restarts Radarr if a setting requiring it changed and waits for it to answer on the new address,
+// which is then used by the resources configured afterwards.
+func (r *HostResource) restart(ctx context.Context, current, host *radarr.HostConfigResource, action string, diags *diag.Diagnostics) {
+	if !hostRequiresRestart(current, host) {
+		return
+	}
+
+	status, _, err := r.client.SystemAPI.GetSystemStatus(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, hostResourceName, err))
+
+		return
+	}
+
+	if _, err := r.client.SystemAPI.CreateSystemRestart(r.auth).Execute(); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, hostResourceName, err))
+
+		return
+	}
+
+	auth := hostAddress(r.auth, current, host)
+	started := status.GetStartTime()
+
+	if _, err := helpers.WaitSystemStatus(helpers.WithAuth(ctx, auth), r.client, hostRestartTimeout, helpers.CommandPollInterval, func(s *radarr.SystemResource) bool {
+		return s.GetStartTime().After(started)
+	}); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, hostResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "restarted "+hostResourceName)
+
+	r.auth = auth
+	r.data.setAuth(auth)
+}
+
+// resetAPIKey runs the Radarr command generating a new API key and reads it back,
+// the host config endpoint ignores any key sent with it.
+func (r *HostResource) resetAPIKey(ctx context.Context, current *radarr.HostConfigResource, action string, diags *diag.Diagnostics) *radarr.HostConfigResource {
+	command, err := helpers.CreateCommand(r.auth, r.client, hostResetAPIKeyCommand, nil)
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, hostResourceName, err))
+
+		return nil
+	}
+
+	if _, err := helpers.WaitCommand(helpers.WithAuth(ctx, r.auth), r.client, command.GetId(), commandDefaultTimeout*time.Second, helpers.CommandPollInterval); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, hostResourceName, err))
+
+		return nil
+	}
+
+	host, _, err := r.client.HostConfigAPI.GetHostConfig(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, hostResourceName, err))
+
+		return nil
+	}
+
+	if host.GetApiKey() == "" || host.GetApiKey() == current.GetApiKey() {
+		diags.AddError(helpers.ResourceError, "Unable to reset "+hostResourceName+" API key, Radarr kept the current one")
+
+		return nil
+	}
+
+	tflog.Trace(ctx, "reset "+hostResourceName+" API key")
+	r.switchAPIKey(ctx, current, host)
+
+	return host
+}
+
+// switchAPIKey makes the provider use the new API key, if it changed.
+func (r *HostResource) switchAPIKey(ctx context.Context, current, host *radarr.HostConfigResource) {
+	if host.GetApiKey() == "" || host.GetApiKey() == current.GetApiKey() {
+		return
+	}
+
+	auth := context.WithValue(r.auth, radarr.ContextAPIKeys, map[string]radarr.APIKey{
+		"X-Api-Key": {Key: host.GetApiKey()},
+	})
+
+	tflog.Trace(ctx, "switched "+hostResourceName+" API key")
+
+	r.auth = auth
+	r.data.setAuth(auth)
+}
+
+// hostRequiresRestart checks if any setting applied only at startup changed.
+func hostRequiresRestart(current, host *radarr.HostConfigResource) bool {
+	return current.GetPort() != host.GetPort() ||
+		current.GetBindAddress() != host.GetBindAddress() ||
+		current.GetUrlBase() != host.GetUrlBase() ||
+		current.GetEnableSsl() != host.GetEnableSsl() ||
+		current.GetSslPort() != host.GetSslPort() ||
+		current.GetSslCertPath() != host.GetSslCertPath() ||
+		current.GetAuthenticationMethod() != host.GetAuthenticationMethod()
+}
+
+// hostAddress returns the auth pointing to the address Radarr answers on after the restart.
+// The port and scheme are only replaced when the current port is explicitly used, e.g. not behind a reverse proxy.
+func hostAddress(auth context.Context, current, host *radarr.HostConfigResource) context.Context {
+	variables, ok := auth.Value(radarr.ContextServerVariables).(map[string]string)
+	if !ok {
+		return auth
+	}
+
+	address, err := url.Parse(variables["protocol"] + "://" + variables["hostpath"])
+	if err != nil {
+		return auth
+	}
+
+	oldPort, newPort := current.GetPort(), host.GetPort()
+	if address.Scheme == "https" {
+		oldPort, newPort = current.GetSslPort(), host.GetSslPort()
+	}
+
+	if address.Port() == strconv.Itoa(int(oldPort)) {
+		// Radarr only answers on the plain port once SSL is disabled
+		if address.Scheme == "https" && !host.GetEnableSsl() {
+			address.Scheme = "http"
+			newPort = host.GetPort()
+		}
+
+		address.Host = net.JoinHostPort(address.Hostname(), strconv.Itoa(int(newPort)))
+	}
+
+	if current.GetUrlBase() != host.GetUrlBase() {
+		address.Path = strings.TrimSuffix(host.GetUrlBase(), "/")
+	}
+
+	return context.WithValue(auth, radarr.ContextServerVariables, map[string]string{
+		"protocol": address.Scheme,
+		"hostpath": address.Host + address.Path,
+	})
 }
 
 func (h *Host) write(ctx context.Context, host *radarr.HostConfigResource, diags *diag.Diagnostics) {
@@ -1,11 +1,20 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/devopsarr/radarr-go/radarr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccHostResource(t *testing.T) {
@@ -26,6 +35,8 @@ func TestAccHostResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("radarr_host.test", "port", "7878"),
 					resource.TestCheckResourceAttrSet("radarr_host.test", "id"),
+					resource.TestCheckResourceAttr("radarr_host.test", "restart_on_change", "false"),
+					resource.TestCheckResourceAttrSet("radarr_host.test", "api_key"),
 				),
 			},
 			// Unauthorized Read
@@ -52,6 +63,142 @@ func TestAccHostResource(t *testing.T) {
 	})
 }
 
+func TestAccHostResourceResetAPIKey(t *testing.T) {
+	t.Parallel()
+
+	server, status := testHostResetAPIKeyServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccHostResourceResetAPIKeyConfig(server.URL, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_host.test", "api_key", "StubAPIKey"),
+					resource.TestCheckResourceAttr("data.radarr_system_status.test", "version", "5.0.0"),
+				),
+			},
+			// Reset API key testing
+			{
+				Config: testAccHostResourceResetAPIKeyConfig(server.URL, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_host.test", "api_key", "StubResetAPIKey"),
+					resource.TestCheckResourceAttr("data.radarr_system_status.test", "version", "5.0.0"),
+					func(_ *terraform.State) error {
+						if key := status(); key != "StubResetAPIKey" {
+							return fmt.Errorf("system status read with API key %q after the reset", key)
+						}
+
+						return nil
+					},
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testHostResetAPIKeyServer stubs the endpoints used by the API key reset, since it would break the other tests.
+// It keeps accepting the old key for the refresh following the apply, and returns the key the system status was read with.
+func testHostResetAPIKeyServer() (*httptest.Server, func() string) {
+	var (
+		mu        sync.Mutex
+		current   = "StubAPIKey"
+		statusKey string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		key := r.Header.Get("X-Api-Key")
+		if key != "StubAPIKey" && key != current {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v3/config/host":
+			_, _ = fmt.Fprintf(w, `{"id": 1, "port": 7878, "bindAddress": "*", "authenticationMethod": "none", "apiKey": "%s"}`, current)
+		case "PUT /api/v3/config/host/1":
+			// the API key sent is ignored, as Radarr does
+			host := map[string]interface{}{}
+			_ = json.NewDecoder(r.Body).Decode(&host)
+			host["apiKey"] = current
+			_ = json.NewEncoder(w).Encode(host)
+		case "POST /api/v3/command":
+			current = "StubResetAPIKey"
+			_, _ = w.Write([]byte(`{"id": 1, "name": "ResetApiKey", "status": "queued"}`))
+		case "GET /api/v3/command/1":
+			_, _ = w.Write([]byte(`{"id": 1, "name": "ResetApiKey", "status": "completed"}`))
+		case "GET /api/v3/system/status":
+			statusKey = key
+			_, _ = w.Write([]byte(`{"version": "5.0.0"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	return server, func() string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return statusKey
+	}
+}
+
+func testAccHostResourceResetAPIKeyConfig(url, rotate string) string {
+	return fmt.Sprintf(`
+	provider "radarr" {
+		url = "%s"
+		api_key = "StubAPIKey"
+	}
+
+	resource "radarr_host" "test" {
+		launch_browser = true
+		port = 7878
+		url_base = ""
+		bind_address = "*"
+		application_url =  ""
+		instance_name = "Radarr"
+		rotate_api_key = {
+			step = "%s"
+		}
+		proxy = {
+			enabled = false
+		}
+		ssl = {
+			enabled = false
+			certificate_validation = "enabled"
+		}
+		logging = {
+			log_level = "info"
+			log_size_limit = 1
+		}
+		backup = {
+			folder = "/backup"
+			interval = 5
+			retention = 10
+		}
+		authentication = {
+			method = "none"
+		}
+		update = {
+			mechanism = "docker"
+			branch = "develop"
+		}
+	}
+
+	data "radarr_system_status" "test" {
+		depends_on = [radarr_host.test]
+	}`, url, rotate)
+}
+
 func testAccHostResourceConfig(name, password string) string {
 	return fmt.Sprintf(`
 	resource "radarr_host" "test" {
@@ -88,3 +235,162 @@ func testAccHostResourceConfig(name, password string) string {
 		}
 	}`, name, password)
 }
+
+func testHostConfig(edit func(*radarr.HostConfigResource)) *radarr.HostConfigResource {
+	host := radarr.NewHostConfigResource()
+	host.SetPort(7878)
+	host.SetSslPort(9898)
+	host.SetBindAddress("*")
+	host.SetUrlBase("")
+	host.SetEnableSsl(false)
+	host.SetAuthenticationMethod(radarr.AUTHENTICATIONTYPE_NONE)
+
+	if edit != nil {
+		edit(host)
+	}
+
+	return host
+}
+
+func TestHostRequiresRestart(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		edit     func(*radarr.HostConfigResource)
+		expected bool
+	}{
+		"no change": {
+			expected: false,
+		},
+		"instance name": {
+			edit:     func(h *radarr.HostConfigResource) { h.SetInstanceName("Test") },
+			expected: false,
+		},
+		"port": {
+			edit:     func(h *radarr.HostConfigResource) { h.SetPort(8080) },
+			expected: true,
+		},
+		"url base": {
+			edit:     func(h *radarr.HostConfigResource) { h.SetUrlBase("/radarr") },
+			expected: true,
+		},
+		"bind address": {
+			edit:     func(h *radarr.HostConfigResource) { h.SetBindAddress("127.0.0.1") },
+			expected: true,
+		},
+		"ssl port": {
+			edit:     func(h *radarr.HostConfigResource) { h.SetSslPort(9999) },
+			expected: true,
+		},
+		"enable ssl": {
+			edit:     func(h *radarr.HostConfigResource) { h.SetEnableSsl(true) },
+			expected: true,
+		},
+		"authentication method": {
+			edit:     func(h *radarr.HostConfigResource) { h.SetAuthenticationMethod(radarr.AUTHENTICATIONTYPE_FORMS) },
+			expected: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, hostRequiresRestart(testHostConfig(nil), testHostConfig(test.edit)))
+		})
+	}
+}
+
+func TestHostAddress(t *testing.T) {
+	t.Parallel()
+
+	sslEnabled := func(h *radarr.HostConfigResource) { h.SetEnableSsl(true) }
+
+	tests := map[string]struct {
+		current  func(*radarr.HostConfigResource)
+		host     func(*radarr.HostConfigResource)
+		address  string
+		expected string
+	}{
+		"no change": {
+			address:  "http://localhost:7878",
+			expected: "http://localhost:7878",
+		},
+		"port": {
+			host:     func(h *radarr.HostConfigResource) { h.SetPort(8080) },
+			address:  "http://localhost:7878",
+			expected: "http://localhost:8080",
+		},
+		"port behind proxy": {
+			host:     func(h *radarr.HostConfigResource) { h.SetPort(8080) },
+			address:  "https://radarr.example.com",
+			expected: "https://radarr.example.com",
+		},
+		"url base": {
+			host:     func(h *radarr.HostConfigResource) { h.SetUrlBase("/radarr/") },
+			address:  "http://localhost:7878",
+			expected: "http://localhost:7878/radarr",
+		},
+		"url base removed": {
+			current:  func(h *radarr.HostConfigResource) { h.SetUrlBase("/radarr") },
+			address:  "http://localhost:7878/radarr",
+			expected: "http://localhost:7878",
+		},
+		"bind address": {
+			host:     func(h *radarr.HostConfigResource) { h.SetBindAddress("127.0.0.1") },
+			address:  "http://localhost:7878",
+			expected: "http://localhost:7878",
+		},
+		"ssl port": {
+			current:  sslEnabled,
+			host:     func(h *radarr.HostConfigResource) { h.SetEnableSsl(true); h.SetSslPort(9999) },
+			address:  "https://localhost:9898",
+			expected: "https://localhost:9999",
+		},
+		"ssl port on plain address": {
+			current:  sslEnabled,
+			host:     func(h *radarr.HostConfigResource) { h.SetEnableSsl(true); h.SetSslPort(9999) },
+			address:  "http://localhost:7878",
+			expected: "http://localhost:7878",
+		},
+		"ssl enabled": {
+			host:     sslEnabled,
+			address:  "http://localhost:7878",
+			expected: "http://localhost:7878",
+		},
+		"ssl disabled": {
+			current:  sslEnabled,
+			address:  "https://localhost:9898",
+			expected: "http://localhost:7878",
+		},
+		"ssl disabled behind proxy": {
+			current:  sslEnabled,
+			address:  "https://radarr.example.com",
+			expected: "https://radarr.example.com",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			scheme, hostpath, _ := strings.Cut(test.address, "://")
+			auth := context.WithValue(context.Background(), radarr.ContextServerVariables, map[string]string{
+				"protocol": scheme,
+				"hostpath": hostpath,
+			})
+
+			variables, _ := hostAddress(auth, testHostConfig(test.current), testHostConfig(test.host)).Value(radarr.ContextServerVariables).(map[string]string)
+			assert.Equal(t, test.expected, variables["protocol"]+"://"+variables["hostpath"])
+		})
+	}
+}
+
+func TestHostAddressWithoutServer(t *testing.T) {
+	t.Parallel()
+
+	auth := context.Background()
+	assert.Equal(t, auth, hostAddress(auth, testHostConfig(nil), testHostConfig(func(h *radarr.HostConfigResource) { h.SetPort(8080) })))
+}
@@ -0,0 +1,334 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const movieBulkEditResourceName = "movie_bulk_edit"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                     = &MovieBulkEditResource{}
+	_ resource.ResourceWithConfigValidators = &MovieBulkEditResource{}
+)
+
+func NewMovieBulkEditResource() resource.Resource {
+	return &MovieBulkEditResource{}
+}
+
+// MovieBulkEditResource defines the movie bulk edit implementation.
+type MovieBulkEditResource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// MovieBulkEdit describes the movie bulk edit data model.
+type MovieBulkEdit struct {
+	Tags                types.Set    `tfsdk:"tags"`
+	MovieIDs            types.Set    `tfsdk:"movie_ids"`
+	Selector            types.Object `tfsdk:"selector"`
+	ApplyTags           types.String `tfsdk:"apply_tags"`
+	MinimumAvailability types.String `tfsdk:"minimum_availability"`
+	RootFolderPath      types.String `tfsdk:"root_folder_path"`
+	QualityProfileID    types.Int64  `tfsdk:"quality_profile_id"`
+	Monitored           types.Bool   `tfsdk:"monitored"`
+	MoveFiles           types.Bool   `tfsdk:"move_files"`
+}
+
+// MovieBulkEditSelector is part of MovieBulkEdit.
+type MovieBulkEditSelector struct {
+	Tags             types.Set    `tfsdk:"tags"`
+	TagsMatch        types.String `tfsdk:"tags_match"`
+	RootFolderPath   types.String `tfsdk:"root_folder_path"`
+	QualityProfileID types.Int64  `tfsdk:"quality_profile_id"`
+	YearMin          types.Int64  `tfsdk:"year_min"`
+	YearMax          types.Int64  `tfsdk:"year_max"`
+}
+
+func (s MovieBulkEditSelector) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"tags":               types.SetType{}.WithElementType(types.Int64Type),
+			"tags_match":         types.StringType,
+			"root_folder_path":   types.StringType,
+			"quality_profile_id": types.Int64Type,
+			"year_min":           types.Int64Type,
+			"year_max":           types.Int64Type,
+		})
+}
+
+func (r *MovieBulkEditResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + movieBulkEditResourceName
+}
+
+func (r *MovieBulkEditResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Movies -->\nMovie Bulk Edit resource.\nApplies the given settings to all the movies matching the selector through the movie editor.\nSettings are applied on create and on every change of the resource, destroying it does not revert the movies.",
+		Attributes: map[string]schema.Attribute{
+			"selector": schema.SingleNestedAttribute{
+				MarkdownDescription: "Movie selector. Selector fields are combined, only movies matching all of them are edited. At least one of `tags`, `quality_profile_id`, `root_folder_path`, `year_min` or `year_max` must be set.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.SetAttribute{
+						MarkdownDescription: "Select movies with these tags.",
+						Optional:            true,
+						ElementType:         types.Int64Type,
+					},
+					"tags_match": schema.StringAttribute{
+						MarkdownDescription: "Tags match mode. Allowed values: 'any' (default), 'all'.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("any", "all"),
+						},
+					},
+					"quality_profile_id": schema.Int64Attribute{
+						MarkdownDescription: "Select movies with this current quality profile ID.",
+						Optional:            true,
+					},
+					"root_folder_path": schema.StringAttribute{
+						MarkdownDescription: "Select movies inside this root folder, not the sibling folders sharing its name as prefix.",
+						Optional:            true,
+					},
+					"year_min": schema.Int64Attribute{
+						MarkdownDescription: "Select movies released in or after this year.",
+						Optional:            true,
+					},
+					"year_max": schema.Int64Attribute{
+						MarkdownDescription: "Select movies released in or before this year.",
+						Optional:            true,
+					},
+				},
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile ID to apply.",
+				Optional:            true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag to apply.",
+				Optional:            true,
+			},
+			"minimum_availability": schema.StringAttribute{
+				MarkdownDescription: "Minimum availability to apply.\nAllowed values: 'tba', 'announced', 'inCinemas', 'released', 'deleted'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("tba", "announced", "inCinemas", "released", "deleted"),
+				},
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Root folder path to apply.",
+				Optional:            true,
+			},
+			"move_files": schema.BoolAttribute{
+				MarkdownDescription: "Move movie files when `root_folder_path` is applied. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags to apply according to `apply_tags`.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"apply_tags": schema.StringAttribute{
+				MarkdownDescription: "How `tags` are applied. Allowed values: 'add' (default), 'remove', 'replace'.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(radarr.APPLYTAGS_ADD)),
+				Validators: []validator.String{
+					stringvalidator.OneOf("add", "remove", "replace"),
+				},
+			},
+			"movie_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the movies affected by the last edit.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+	}
+}
+
+func (r *MovieBulkEditResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("quality_profile_id"),
+			path.MatchRoot("monitored"),
+			path.MatchRoot("minimum_availability"),
+			path.MatchRoot("root_folder_path"),
+			path.MatchRoot("tags"),
+		),
+		// An empty selector would edit the whole library
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("selector").AtName("tags"),
+			path.MatchRoot("selector").AtName("quality_profile_id"),
+			path.MatchRoot("selector").AtName("root_folder_path"),
+			path.MatchRoot("selector").AtName("year_min"),
+			path.MatchRoot("selector").AtName("year_max"),
+		),
+	}
+}
+
+func (r *MovieBulkEditResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *MovieBulkEditResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var edit *MovieBulkEdit
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &edit)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.edit(ctx, edit, helpers.Create, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+movieBulkEditResourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &edit)...)
+}
+
+func (r *MovieBulkEditResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Edited movies do not match the selector anymore once edited, keep the last applied result
+	var edit *MovieBulkEdit
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &edit)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+movieBulkEditResourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &edit)...)
+}
+
+func (r *MovieBulkEditResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var edit *MovieBulkEdit
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &edit)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.edit(ctx, edit, helpers.Update, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+movieBulkEditResourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &edit)...)
+}
+
+func (r *MovieBulkEditResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Bulk edit cannot be reverted just removing configuration
+	tflog.Trace(ctx, "decoupled "+movieBulkEditResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// edit applies the settings to the selected movies and stores their IDs.
+func (r *MovieBulkEditResource) edit(ctx context.Context, edit *MovieBulkEdit, action string, diags *diag.Diagnostics) {
+	movies, _, err := r.client.MovieAPI.ListMovie(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, movieBulkEditResourceName, err))
+
+		return
+	}
+
+	selector := MovieBulkEditSelector{}
+	diags.Append(edit.Selector.As(ctx, &selector, basetypes.ObjectAsOptions{})...)
+
+	filter := selector.filter(ctx, diags)
+	ids := make([]int32, 0)
+
+	for _, m := range movies {
+		if filter.match(&m) {
+			ids = append(ids, m.GetId())
+		}
+	}
+
+	if len(ids) > 0 {
+		request := edit.read(ctx, ids, diags)
+		if diags.HasError() {
+			return
+		}
+
+		if _, err := r.client.MovieEditorAPI.PutMovieEditor(r.auth).MovieEditorResource(*request).Execute(); err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(action, movieBulkEditResourceName, err))
+
+			return
+		}
+	}
+
+	var tempDiag diag.Diagnostics
+
+	edit.MovieIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, ids)
+	diags.Append(tempDiag...)
+}
+
+func (s *MovieBulkEditSelector) filter(ctx context.Context, diags *diag.Diagnostics) movieFilter {
+	filter := movieFilter{
+		rootFolderPath:   s.RootFolderPath.ValueStringPointer(),
+		qualityProfileID: s.QualityProfileID.ValueInt64Pointer(),
+		yearMin:          s.YearMin.ValueInt64Pointer(),
+		yearMax:          s.YearMax.ValueInt64Pointer(),
+		allTags:          s.TagsMatch.ValueString() == "all",
+	}
+
+	diags.Append(s.Tags.ElementsAs(ctx, &filter.tags, true)...)
+
+	return filter
+}
+
+func (e *MovieBulkEdit) read(ctx context.Context, ids []int32, diags *diag.Diagnostics) *radarr.MovieEditorResource {
+	editor := radarr.NewMovieEditorResource()
+	editor.SetMovieIds(ids)
+	editor.SetMoveFiles(e.MoveFiles.ValueBool())
+
+	if !e.QualityProfileID.IsNull() {
+		editor.SetQualityProfileId(int32(e.QualityProfileID.ValueInt64()))
+	}
+
+	if !e.Monitored.IsNull() {
+		editor.SetMonitored(e.Monitored.ValueBool())
+	}
+
+	if !e.MinimumAvailability.IsNull() {
+		editor.SetMinimumAvailability(radarr.MovieStatusType(e.MinimumAvailability.ValueString()))
+	}
+
+	if !e.RootFolderPath.IsNull() {
+		editor.SetRootFolderPath(e.RootFolderPath.ValueString())
+	}
+
+	if !e.Tags.IsNull() {
+		diags.Append(e.Tags.ElementsAs(ctx, &editor.Tags, true)...)
+		editor.SetApplyTags(radarr.ApplyTags(e.ApplyTags.ValueString()))
+	}
+
+	return editor
+}
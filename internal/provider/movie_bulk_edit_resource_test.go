@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccMovieBulkEditResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccMovieBulkEditResourceConfig(true) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Empty selector
+			{
+				Config:      testAccMovieBulkEditResourceEmptySelector,
+				ExpectError: regexp.MustCompile("Missing Attribute Configuration"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccMovieBulkEditResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_movie_bulk_edit.test", "movie_ids.#", "2"),
+					resource.TestCheckResourceAttr("radarr_movie_bulk_edit.test", "apply_tags", "add"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccMovieBulkEditResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_movie_bulk_edit.test", "monitored", "false"),
+					resource.TestCheckResourceAttr("radarr_movie_bulk_edit.test", "movie_ids.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccMovieBulkEditResourceConfig(monitored bool) string {
+	return fmt.Sprintf(`
+		resource "radarr_tag" "test" {
+			label = "bulkedit"
+		}
+
+		resource "radarr_movie" "star_wars" {
+			monitored = false
+			title = "Star Wars"
+			path = "/config/Star Wars (1977)"
+			quality_profile_id = 1
+			tmdb_id = 11
+			tags = [radarr_tag.test.id]
+
+			lifecycle {
+				ignore_changes = [monitored, minimum_availability]
+			}
+		}
+
+		resource "radarr_movie" "return_of_the_jedi" {
+			monitored = false
+			title = "Return of the Jedi"
+			path = "/config/Return of the Jedi (1983)"
+			quality_profile_id = 1
+			tmdb_id = 1892
+			tags = [radarr_tag.test.id]
+
+			lifecycle {
+				ignore_changes = [monitored, minimum_availability]
+			}
+		}
+
+		resource "radarr_movie_bulk_edit" "test" {
+			selector = {
+				tags = [radarr_tag.test.id]
+				year_min = 1977
+				year_max = 1983
+			}
+
+			monitored = %t
+			minimum_availability = "inCinemas"
+
+			depends_on = [radarr_movie.star_wars, radarr_movie.return_of_the_jedi]
+		}
+	`, monitored)
+}
+
+const testAccMovieBulkEditResourceEmptySelector = `
+	resource "radarr_movie_bulk_edit" "test" {
+		selector = {}
+		monitored = false
+	}
+`
+
+func TestMovieBulkEditSelectorRootFolder(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+
+	selector := MovieBulkEditSelector{
+		Tags:             types.SetNull(types.Int64Type),
+		TagsMatch:        types.StringValue("any"),
+		RootFolderPath:   types.StringValue("/movies/"),
+		QualityProfileID: types.Int64Null(),
+		YearMin:          types.Int64Null(),
+		YearMax:          types.Int64Null(),
+	}
+	filter := selector.filter(context.Background(), &diags)
+
+	selected := []string{}
+
+	for _, root := range []string{"/movies", "/movies-4k", "/movies_old"} {
+		movie := radarr.NewMovieResource()
+		movie.SetRootFolderPath(root)
+		movie.SetPath(root + "/Gladiator (2000)")
+
+		if filter.match(movie) {
+			selected = append(selected, root)
+		}
+	}
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{"/movies"}, selected)
+}
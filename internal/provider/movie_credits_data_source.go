@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const movieCreditsDataSourceName = "movie_credits"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = &MovieCreditsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &MovieCreditsDataSource{}
+)
+
+func NewMovieCreditsDataSource() datasource.DataSource {
+	return &MovieCreditsDataSource{}
+}
+
+// MovieCreditsDataSource defines the movie credits implementation.
+type MovieCreditsDataSource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// MovieCredits describes the movie credits data model.
+type MovieCredits struct {
+	Credits types.Set    `tfsdk:"credits"`
+	ID      types.String `tfsdk:"id"`
+	MovieID types.Int64  `tfsdk:"movie_id"`
+	TMDBID  types.Int64  `tfsdk:"tmdb_id"`
+}
+
+// MovieCredit is part of MovieCredits.
+type MovieCredit struct {
+	PersonName   types.String `tfsdk:"person_name"`
+	Character    types.String `tfsdk:"character"`
+	Job          types.String `tfsdk:"job"`
+	Department   types.String `tfsdk:"department"`
+	Type         types.String `tfsdk:"type"`
+	PersonTMDBID types.Int64  `tfsdk:"person_tmdb_id"`
+	Order        types.Int64  `tfsdk:"order"`
+}
+
+func (c MovieCredit) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"person_name":    types.StringType,
+			"character":      types.StringType,
+			"job":            types.StringType,
+			"department":     types.StringType,
+			"type":           types.StringType,
+			"person_tmdb_id": types.Int64Type,
+			"order":          types.Int64Type,
+		})
+}
+
+func (d *MovieCreditsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + movieCreditsDataSourceName
+}
+
+func (d *MovieCreditsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nList cast and crew of a [Movie](../resources/movie) in library.\nExactly one of `movie_id` or `tmdb_id` must be set.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie ID.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"tmdb_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie TMDB ID.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"credits": schema.SetNestedAttribute{
+				MarkdownDescription: "Credit list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"person_name": schema.StringAttribute{
+							MarkdownDescription: "Person name.",
+							Computed:            true,
+						},
+						"person_tmdb_id": schema.Int64Attribute{
+							MarkdownDescription: "Person TMDB ID.",
+							Computed:            true,
+						},
+						"character": schema.StringAttribute{
+							MarkdownDescription: "Character played, only for cast.",
+							Computed:            true,
+						},
+						"job": schema.StringAttribute{
+							MarkdownDescription: "Job, only for crew.",
+							Computed:            true,
+						},
+						"department": schema.StringAttribute{
+							MarkdownDescription: "Department, only for crew.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Credit type. Either 'cast' or 'crew'.",
+							Computed:            true,
+						},
+						"order": schema.Int64Attribute{
+							MarkdownDescription: "Billing order.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MovieCreditsDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("movie_id"),
+			path.MatchRoot("tmdb_id"),
+		),
+	}
+}
+
+func (d *MovieCreditsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *MovieCreditsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *MovieCredits
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the library movie
+	movie := d.movie(data, &resp.Diagnostics)
+	if movie == nil {
+		return
+	}
+
+	// Get credits current value, the client does not decode the body
+	httpResp, err := d.client.CreditAPI.GetCredit(d.auth).MovieId(movie.GetId()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieCreditsDataSourceName, err))
+
+		return
+	}
+
+	var response []radarr.CreditResource
+	if err := json.NewDecoder(httpResp.Body).Decode(&response); err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieCreditsDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+movieCreditsDataSourceName)
+	// Map response body to resource schema attribute
+	credits := make([]MovieCredit, len(response))
+	for i, c := range response {
+		credits[i].write(&c)
+	}
+
+	creditList, diags := types.SetValueFrom(ctx, MovieCredit{}.getType(), credits)
+	resp.Diagnostics.Append(diags...)
+
+	data.Credits = creditList
+	data.MovieID = types.Int64Value(int64(movie.GetId()))
+	data.TMDBID = types.Int64Value(int64(movie.GetTmdbId()))
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// movie gets the library movie by ID or TMDB ID.
+func (d *MovieCreditsDataSource) movie(data *MovieCredits, diags *diag.Diagnostics) *radarr.MovieResource {
+	if data.TMDBID.IsNull() {
+		movie, _, err := d.client.MovieAPI.GetMovieById(d.auth, int32(data.MovieID.ValueInt64())).Execute()
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieCreditsDataSourceName, err))
+
+			return nil
+		}
+
+		return movie
+	}
+
+	movies, _, err := d.client.MovieAPI.ListMovie(d.auth).TmdbId(int32(data.TMDBID.ValueInt64())).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieCreditsDataSourceName, err))
+
+		return nil
+	}
+
+	for i, m := range movies {
+		if int64(m.GetTmdbId()) == data.TMDBID.ValueInt64() {
+			return &movies[i]
+		}
+	}
+
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(movieDataSourceName, "TMDB ID", strconv.Itoa(int(data.TMDBID.ValueInt64()))))
+
+	return nil
+}
+
+func (c *MovieCredit) write(credit *radarr.CreditResource) {
+	c.PersonName = types.StringValue(credit.GetPersonName())
+	c.PersonTMDBID = types.Int64Value(int64(credit.GetPersonTmdbId()))
+	c.Character = types.StringValue(credit.GetCharacter())
+	c.Job = types.StringValue(credit.GetJob())
+	c.Department = types.StringValue(credit.GetDepartment())
+	c.Type = types.StringValue(string(credit.GetType()))
+	c.Order = types.Int64Value(int64(credit.GetOrder()))
+}
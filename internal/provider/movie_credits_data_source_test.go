@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMovieCreditsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccMovieCreditsDataSourceConfig("movie_id = 999") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      testAccMovieCreditsDataSourceConfig("tmdb_id = 999999"),
+				ExpectError: regexp.MustCompile("Unable to find movie"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccMovieResourceConfig("The Empire Strikes Back", "Empire", 1891) + testAccMovieCreditsDataSourceConfig("tmdb_id = radarr_movie.test.tmdb_id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.radarr_movie_credits.test", "movie_id", "radarr_movie.test", "id"),
+					resource.TestCheckResourceAttrSet("data.radarr_movie_credits.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMovieCreditsDataSourceConfig(search string) string {
+	return `
+	data "radarr_movie_credits" "test" {
+		` + search + `
+	}
+	`
+}
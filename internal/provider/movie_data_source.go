@@ -121,6 +121,186 @@ func (d *MovieDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					},
 				},
 			},
+			"has_file": schema.BoolAttribute{
+				MarkdownDescription: "Has file flag.",
+				Computed:            true,
+			},
+			"size_on_disk": schema.Int64Attribute{
+				MarkdownDescription: "Size on disk in bytes.",
+				Computed:            true,
+			},
+			"runtime": schema.Int64Attribute{
+				MarkdownDescription: "Runtime in minutes.",
+				Computed:            true,
+			},
+			"popularity": schema.Float64Attribute{
+				MarkdownDescription: "Popularity.",
+				Computed:            true,
+			},
+			"studio": schema.StringAttribute{
+				MarkdownDescription: "Studio.",
+				Computed:            true,
+			},
+			"certification": schema.StringAttribute{
+				MarkdownDescription: "Certification.",
+				Computed:            true,
+			},
+			"added": schema.StringAttribute{
+				MarkdownDescription: "Date the movie was added to Radarr, in RFC3339 format.",
+				Computed:            true,
+			},
+			"in_cinemas": schema.StringAttribute{
+				MarkdownDescription: "In cinemas release date, in RFC3339 format.",
+				Computed:            true,
+			},
+			"digital_release": schema.StringAttribute{
+				MarkdownDescription: "Digital release date, in RFC3339 format.",
+				Computed:            true,
+			},
+			"physical_release": schema.StringAttribute{
+				MarkdownDescription: "Physical release date, in RFC3339 format.",
+				Computed:            true,
+			},
+			"last_search_time": schema.StringAttribute{
+				MarkdownDescription: "Last automatic search time, in RFC3339 format.",
+				Computed:            true,
+			},
+			"images": schema.SetNestedAttribute{
+				MarkdownDescription: "Images.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: d.getImageSchema().Attributes,
+				},
+			},
+			"ratings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Ratings.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"imdb": schema.SingleNestedAttribute{
+						MarkdownDescription: "IMDB rating.",
+						Computed:            true,
+						Attributes:          d.getRatingSchema().Attributes,
+					},
+					"tmdb": schema.SingleNestedAttribute{
+						MarkdownDescription: "TMDB rating.",
+						Computed:            true,
+						Attributes:          d.getRatingSchema().Attributes,
+					},
+					"metacritic": schema.SingleNestedAttribute{
+						MarkdownDescription: "Metacritic rating.",
+						Computed:            true,
+						Attributes:          d.getRatingSchema().Attributes,
+					},
+					"rotten_tomatoes": schema.SingleNestedAttribute{
+						MarkdownDescription: "Rotten Tomatoes rating.",
+						Computed:            true,
+						Attributes:          d.getRatingSchema().Attributes,
+					},
+					"trakt": schema.SingleNestedAttribute{
+						MarkdownDescription: "Trakt rating.",
+						Computed:            true,
+						Attributes:          d.getRatingSchema().Attributes,
+					},
+				},
+			},
+			"movie_file": schema.SingleNestedAttribute{
+				MarkdownDescription: "Movie file. Null if the movie has no file.",
+				Computed:            true,
+				Attributes:          d.getMovieFileSchema().Attributes,
+			},
+			"collection": schema.SingleNestedAttribute{
+				MarkdownDescription: "Collection the movie belongs to. Null if the movie is not part of a collection.",
+				Computed:            true,
+				Attributes:          d.getCollectionSchema().Attributes,
+			},
+		},
+	}
+}
+
+func (d MovieDataSource) getRatingSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Rating type.",
+				Computed:            true,
+			},
+			"votes": schema.Int64Attribute{
+				MarkdownDescription: "Number of votes.",
+				Computed:            true,
+			},
+			"value": schema.Float64Attribute{
+				MarkdownDescription: "Rating value.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d MovieDataSource) getImageSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cover_type": schema.StringAttribute{
+				MarkdownDescription: "Cover type.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Local URL.",
+				Computed:            true,
+			},
+			"remote_url": schema.StringAttribute{
+				MarkdownDescription: "Remote URL.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d MovieDataSource) getMovieFileSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Movie file ID.",
+				Computed:            true,
+			},
+			"relative_path": schema.StringAttribute{
+				MarkdownDescription: "Path relative to the movie folder.",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size in bytes.",
+				Computed:            true,
+			},
+			"date_added": schema.StringAttribute{
+				MarkdownDescription: "Date the file was added, in RFC3339 format.",
+				Computed:            true,
+			},
+			"quality": schema.StringAttribute{
+				MarkdownDescription: "Quality name.",
+				Computed:            true,
+			},
+			"custom_format_score": schema.Int64Attribute{
+				MarkdownDescription: "Custom format score.",
+				Computed:            true,
+			},
+			"quality_cutoff_not_met": schema.BoolAttribute{
+				MarkdownDescription: "Quality cutoff not met flag.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d MovieDataSource) getCollectionSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Collection title.",
+				Computed:            true,
+			},
+			"tmdb_id": schema.Int64Attribute{
+				MarkdownDescription: "Collection TMDB ID.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -31,6 +31,10 @@ func TestAccMovieDataSource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.radarr_movie.test", "id"),
 					resource.TestCheckResourceAttr("data.radarr_movie.test", "title", "Pulp Fiction"),
+					resource.TestCheckResourceAttr("data.radarr_movie.test", "has_file", "false"),
+					resource.TestCheckResourceAttrSet("data.radarr_movie.test", "runtime"),
+					resource.TestCheckResourceAttrSet("data.radarr_movie.test", "in_cinemas"),
+					resource.TestCheckResourceAttrSet("data.radarr_movie.test", "physical_release"),
 				),
 			},
 		},
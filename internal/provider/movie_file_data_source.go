@@ -0,0 +1,404 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const movieFileDataSourceName = "movie_file"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MovieFileDataSource{}
+
+func NewMovieFileDataSource() datasource.DataSource {
+	return &MovieFileDataSource{}
+}
+
+// MovieFileDataSource defines the movie file implementation.
+type MovieFileDataSource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// MovieFile describes the movie file data model.
+type MovieFile struct {
+	Languages           types.Set    `tfsdk:"languages"`
+	CustomFormats       types.Set    `tfsdk:"custom_formats"`
+	Revision            types.Object `tfsdk:"revision"`
+	MediaInfo           types.Object `tfsdk:"media_info"`
+	RelativePath        types.String `tfsdk:"relative_path"`
+	Path                types.String `tfsdk:"path"`
+	DateAdded           types.String `tfsdk:"date_added"`
+	SceneName           types.String `tfsdk:"scene_name"`
+	ReleaseGroup        types.String `tfsdk:"release_group"`
+	Edition             types.String `tfsdk:"edition"`
+	Quality             types.String `tfsdk:"quality"`
+	QualitySource       types.String `tfsdk:"quality_source"`
+	ID                  types.Int64  `tfsdk:"id"`
+	MovieID             types.Int64  `tfsdk:"movie_id"`
+	Size                types.Int64  `tfsdk:"size"`
+	QualityResolution   types.Int64  `tfsdk:"quality_resolution"`
+	CustomFormatScore   types.Int64  `tfsdk:"custom_format_score"`
+	QualityCutoffNotMet types.Bool   `tfsdk:"quality_cutoff_not_met"`
+}
+
+func (f MovieFile) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"languages":              types.SetType{}.WithElementType(types.StringType),
+			"custom_formats":         types.SetType{}.WithElementType(types.StringType),
+			"revision":               MovieFileRevision{}.getType(),
+			"media_info":             MovieFileMediaInfo{}.getType(),
+			"relative_path":          types.StringType,
+			"path":                   types.StringType,
+			"date_added":             types.StringType,
+			"scene_name":             types.StringType,
+			"release_group":          types.StringType,
+			"edition":                types.StringType,
+			"quality":                types.StringType,
+			"quality_source":         types.StringType,
+			"id":                     types.Int64Type,
+			"movie_id":               types.Int64Type,
+			"size":                   types.Int64Type,
+			"quality_resolution":     types.Int64Type,
+			"custom_format_score":    types.Int64Type,
+			"quality_cutoff_not_met": types.BoolType,
+		})
+}
+
+// MovieFileRevision is part of MovieFile.
+type MovieFileRevision struct {
+	Version  types.Int64 `tfsdk:"version"`
+	Real     types.Int64 `tfsdk:"real"`
+	IsRepack types.Bool  `tfsdk:"is_repack"`
+}
+
+func (r MovieFileRevision) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"version":   types.Int64Type,
+			"real":      types.Int64Type,
+			"is_repack": types.BoolType,
+		})
+}
+
+// MovieFileMediaInfo is part of MovieFile.
+type MovieFileMediaInfo struct {
+	VideoCodec            types.String  `tfsdk:"video_codec"`
+	VideoDynamicRange     types.String  `tfsdk:"video_dynamic_range"`
+	VideoDynamicRangeType types.String  `tfsdk:"video_dynamic_range_type"`
+	Resolution            types.String  `tfsdk:"resolution"`
+	ScanType              types.String  `tfsdk:"scan_type"`
+	RunTime               types.String  `tfsdk:"run_time"`
+	AudioCodec            types.String  `tfsdk:"audio_codec"`
+	AudioLanguages        types.String  `tfsdk:"audio_languages"`
+	Subtitles             types.String  `tfsdk:"subtitles"`
+	VideoBitDepth         types.Int64   `tfsdk:"video_bit_depth"`
+	VideoBitrate          types.Int64   `tfsdk:"video_bitrate"`
+	AudioBitrate          types.Int64   `tfsdk:"audio_bitrate"`
+	AudioStreamCount      types.Int64   `tfsdk:"audio_stream_count"`
+	VideoFps              types.Float64 `tfsdk:"video_fps"`
+	AudioChannels         types.Float64 `tfsdk:"audio_channels"`
+}
+
+func (m MovieFileMediaInfo) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"video_codec":              types.StringType,
+			"video_dynamic_range":      types.StringType,
+			"video_dynamic_range_type": types.StringType,
+			"resolution":               types.StringType,
+			"scan_type":                types.StringType,
+			"run_time":                 types.StringType,
+			"audio_codec":              types.StringType,
+			"audio_languages":          types.StringType,
+			"subtitles":                types.StringType,
+			"video_bit_depth":          types.Int64Type,
+			"video_bitrate":            types.Int64Type,
+			"audio_bitrate":            types.Int64Type,
+			"audio_stream_count":       types.Int64Type,
+			"video_fps":                types.Float64Type,
+			"audio_channels":           types.Float64Type,
+		})
+}
+
+func (d *MovieFileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + movieFileDataSourceName
+}
+
+func (d *MovieFileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nFile of a single [Movie](../resources/movie).",
+		Attributes: map[string]schema.Attribute{
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie ID.",
+				Required:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Movie file ID.",
+				Computed:            true,
+			},
+			"relative_path": schema.StringAttribute{
+				MarkdownDescription: "Path relative to the movie folder.",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Full path.",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size in bytes.",
+				Computed:            true,
+			},
+			"date_added": schema.StringAttribute{
+				MarkdownDescription: "Date added in RFC3339 format.",
+				Computed:            true,
+			},
+			"scene_name": schema.StringAttribute{
+				MarkdownDescription: "Scene name.",
+				Computed:            true,
+			},
+			"release_group": schema.StringAttribute{
+				MarkdownDescription: "Release group.",
+				Computed:            true,
+			},
+			"edition": schema.StringAttribute{
+				MarkdownDescription: "Edition.",
+				Computed:            true,
+			},
+			"quality": schema.StringAttribute{
+				MarkdownDescription: "Quality name.",
+				Computed:            true,
+			},
+			"quality_source": schema.StringAttribute{
+				MarkdownDescription: "Quality source.",
+				Computed:            true,
+			},
+			"quality_resolution": schema.Int64Attribute{
+				MarkdownDescription: "Quality resolution.",
+				Computed:            true,
+			},
+			"quality_cutoff_not_met": schema.BoolAttribute{
+				MarkdownDescription: "Quality cutoff not met flag.",
+				Computed:            true,
+			},
+			"revision": schema.SingleNestedAttribute{
+				MarkdownDescription: "Quality revision.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"version": schema.Int64Attribute{
+						MarkdownDescription: "Version.",
+						Computed:            true,
+					},
+					"real": schema.Int64Attribute{
+						MarkdownDescription: "Real.",
+						Computed:            true,
+					},
+					"is_repack": schema.BoolAttribute{
+						MarkdownDescription: "Repack flag.",
+						Computed:            true,
+					},
+				},
+			},
+			"languages": schema.SetAttribute{
+				MarkdownDescription: "Language names.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"custom_formats": schema.SetAttribute{
+				MarkdownDescription: "Matched custom format names.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"custom_format_score": schema.Int64Attribute{
+				MarkdownDescription: "Total custom format score.",
+				Computed:            true,
+			},
+			"media_info": schema.SingleNestedAttribute{
+				MarkdownDescription: "Media info.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"video_codec": schema.StringAttribute{
+						MarkdownDescription: "Video codec.",
+						Computed:            true,
+					},
+					"video_bit_depth": schema.Int64Attribute{
+						MarkdownDescription: "Video bit depth.",
+						Computed:            true,
+					},
+					"video_bitrate": schema.Int64Attribute{
+						MarkdownDescription: "Video bitrate.",
+						Computed:            true,
+					},
+					"video_fps": schema.Float64Attribute{
+						MarkdownDescription: "Video FPS.",
+						Computed:            true,
+					},
+					"video_dynamic_range": schema.StringAttribute{
+						MarkdownDescription: "Video dynamic range.",
+						Computed:            true,
+					},
+					"video_dynamic_range_type": schema.StringAttribute{
+						MarkdownDescription: "Video dynamic range type (HDR type).",
+						Computed:            true,
+					},
+					"resolution": schema.StringAttribute{
+						MarkdownDescription: "Resolution.",
+						Computed:            true,
+					},
+					"scan_type": schema.StringAttribute{
+						MarkdownDescription: "Scan type.",
+						Computed:            true,
+					},
+					"run_time": schema.StringAttribute{
+						MarkdownDescription: "Run time.",
+						Computed:            true,
+					},
+					"audio_codec": schema.StringAttribute{
+						MarkdownDescription: "Audio codec.",
+						Computed:            true,
+					},
+					"audio_channels": schema.Float64Attribute{
+						MarkdownDescription: "Audio channels.",
+						Computed:            true,
+					},
+					"audio_bitrate": schema.Int64Attribute{
+						MarkdownDescription: "Audio bitrate.",
+						Computed:            true,
+					},
+					"audio_stream_count": schema.Int64Attribute{
+						MarkdownDescription: "Audio stream count.",
+						Computed:            true,
+					},
+					"audio_languages": schema.StringAttribute{
+						MarkdownDescription: "Audio languages.",
+						Computed:            true,
+					},
+					"subtitles": schema.StringAttribute{
+						MarkdownDescription: "Subtitles.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MovieFileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *MovieFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *MovieFile
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Get movie file current value
+	response, _, err := d.client.MovieFileAPI.ListMovieFile(d.auth).MovieId([]int32{int32(data.MovieID.ValueInt64())}).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieFileDataSourceName, err))
+
+		return
+	}
+
+	if len(response) == 0 {
+		resp.Diagnostics.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(movieFileDataSourceName, "movie ID", strconv.Itoa(int(data.MovieID.ValueInt64()))))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+movieFileDataSourceName)
+	// Map response body to resource schema attribute
+	data.write(ctx, &response[0], &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (f *MovieFile) write(ctx context.Context, file *radarr.MovieFileResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	f.ID = types.Int64Value(int64(file.GetId()))
+	f.MovieID = types.Int64Value(int64(file.GetMovieId()))
+	f.RelativePath = types.StringValue(file.GetRelativePath())
+	f.Path = types.StringValue(file.GetPath())
+	f.Size = types.Int64Value(file.GetSize())
+	f.DateAdded = helpers.TimeValue(file.DateAdded)
+	f.SceneName = types.StringValue(file.GetSceneName())
+	f.ReleaseGroup = types.StringValue(file.GetReleaseGroup())
+	f.Edition = types.StringValue(file.GetEdition())
+	f.CustomFormatScore = types.Int64Value(int64(file.GetCustomFormatScore()))
+	f.QualityCutoffNotMet = types.BoolValue(file.GetQualityCutoffNotMet())
+
+	quality := file.GetQuality()
+	f.Quality = types.StringValue(quality.Quality.GetName())
+	f.QualitySource = types.StringValue(string(quality.Quality.GetSource()))
+	f.QualityResolution = types.Int64Value(int64(quality.Quality.GetResolution()))
+
+	revision := MovieFileRevision{}
+	revision.write(quality.Revision)
+	f.Revision, tempDiag = types.ObjectValueFrom(ctx, MovieFileRevision{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes(), revision)
+	diags.Append(tempDiag...)
+
+	languages := make([]string, len(file.GetLanguages()))
+	for i, l := range file.GetLanguages() {
+		languages[i] = l.GetName()
+	}
+
+	f.Languages, tempDiag = types.SetValueFrom(ctx, types.StringType, languages)
+	diags.Append(tempDiag...)
+
+	formats := make([]string, len(file.GetCustomFormats()))
+	for i, c := range file.GetCustomFormats() {
+		formats[i] = c.GetName()
+	}
+
+	f.CustomFormats, tempDiag = types.SetValueFrom(ctx, types.StringType, formats)
+	diags.Append(tempDiag...)
+
+	f.MediaInfo = types.ObjectNull(MovieFileMediaInfo{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes())
+	if file.MediaInfo != nil {
+		info := MovieFileMediaInfo{}
+		info.write(file.MediaInfo)
+		f.MediaInfo, tempDiag = types.ObjectValueFrom(ctx, MovieFileMediaInfo{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes(), info)
+		diags.Append(tempDiag...)
+	}
+}
+
+func (r *MovieFileRevision) write(revision *radarr.Revision) {
+	r.Version = types.Int64Value(int64(revision.GetVersion()))
+	r.Real = types.Int64Value(int64(revision.GetReal()))
+	r.IsRepack = types.BoolValue(revision.GetIsRepack())
+}
+
+func (m *MovieFileMediaInfo) write(info *radarr.MediaInfoResource) {
+	m.VideoCodec = types.StringValue(info.GetVideoCodec())
+	m.VideoBitDepth = types.Int64Value(int64(info.GetVideoBitDepth()))
+	m.VideoBitrate = types.Int64Value(info.GetVideoBitrate())
+	m.VideoFps = types.Float64Value(info.GetVideoFps())
+	m.VideoDynamicRange = types.StringValue(info.GetVideoDynamicRange())
+	m.VideoDynamicRangeType = types.StringValue(info.GetVideoDynamicRangeType())
+	m.Resolution = types.StringValue(info.GetResolution())
+	m.ScanType = types.StringValue(info.GetScanType())
+	m.RunTime = types.StringValue(info.GetRunTime())
+	m.AudioCodec = types.StringValue(info.GetAudioCodec())
+	m.AudioChannels = types.Float64Value(info.GetAudioChannels())
+	m.AudioBitrate = types.Int64Value(info.GetAudioBitrate())
+	m.AudioStreamCount = types.Int64Value(int64(info.GetAudioStreamCount()))
+	m.AudioLanguages = types.StringValue(info.GetAudioLanguages())
+	m.Subtitles = types.StringValue(info.GetSubtitles())
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMovieFileDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccMovieFileDataSourceConfig("999") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				PreConfig:   rootFolderDSInit,
+				Config:      testAccMovieResourceConfig("Blade Runner", "BladeRunner", 78) + testAccMovieFileDataSourceConfig("radarr_movie.test.id"),
+				ExpectError: regexp.MustCompile("Unable to find movie_file"),
+			},
+		},
+	})
+}
+
+func testAccMovieFileDataSourceConfig(movieID string) string {
+	return `
+	data "radarr_movie_file" "test" {
+		movie_id = ` + movieID + `
+	}
+	`
+}
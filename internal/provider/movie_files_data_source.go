@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	movieFilesDataSourceName = "movie_files"
+	movieFilesBatchSize      = 100
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MovieFilesDataSource{}
+
+func NewMovieFilesDataSource() datasource.DataSource {
+	return &MovieFilesDataSource{}
+}
+
+// MovieFilesDataSource defines the movie files implementation.
+type MovieFilesDataSource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// MovieFiles describes the movie files data model.
+type MovieFiles struct {
+	MovieFiles types.Set    `tfsdk:"movie_files"`
+	MovieIDs   types.Set    `tfsdk:"movie_ids"`
+	ID         types.String `tfsdk:"id"`
+}
+
+func (d *MovieFilesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + movieFilesDataSourceName
+}
+
+func (d *MovieFilesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nList all available [Movie Files](../data-sources/movie_file).",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"movie_ids": schema.SetAttribute{
+				MarkdownDescription: "Only return the files of these movie IDs. Defaults to all movies.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"movie_files": schema.SetNestedAttribute{
+				MarkdownDescription: "Movie file list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"movie_id": schema.Int64Attribute{
+							MarkdownDescription: "Movie ID.",
+							Computed:            true,
+						},
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Movie file ID.",
+							Computed:            true,
+						},
+						"relative_path": schema.StringAttribute{
+							MarkdownDescription: "Path relative to the movie folder.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Full path.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size in bytes.",
+							Computed:            true,
+						},
+						"date_added": schema.StringAttribute{
+							MarkdownDescription: "Date added in RFC3339 format.",
+							Computed:            true,
+						},
+						"scene_name": schema.StringAttribute{
+							MarkdownDescription: "Scene name.",
+							Computed:            true,
+						},
+						"release_group": schema.StringAttribute{
+							MarkdownDescription: "Release group.",
+							Computed:            true,
+						},
+						"edition": schema.StringAttribute{
+							MarkdownDescription: "Edition.",
+							Computed:            true,
+						},
+						"quality": schema.StringAttribute{
+							MarkdownDescription: "Quality name.",
+							Computed:            true,
+						},
+						"quality_source": schema.StringAttribute{
+							MarkdownDescription: "Quality source.",
+							Computed:            true,
+						},
+						"quality_resolution": schema.Int64Attribute{
+							MarkdownDescription: "Quality resolution.",
+							Computed:            true,
+						},
+						"quality_cutoff_not_met": schema.BoolAttribute{
+							MarkdownDescription: "Quality cutoff not met flag.",
+							Computed:            true,
+						},
+						"revision": schema.SingleNestedAttribute{
+							MarkdownDescription: "Quality revision.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"version": schema.Int64Attribute{
+									MarkdownDescription: "Version.",
+									Computed:            true,
+								},
+								"real": schema.Int64Attribute{
+									MarkdownDescription: "Real.",
+									Computed:            true,
+								},
+								"is_repack": schema.BoolAttribute{
+									MarkdownDescription: "Repack flag.",
+									Computed:            true,
+								},
+							},
+						},
+						"languages": schema.SetAttribute{
+							MarkdownDescription: "Language names.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"custom_formats": schema.SetAttribute{
+							MarkdownDescription: "Matched custom format names.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"custom_format_score": schema.Int64Attribute{
+							MarkdownDescription: "Total custom format score.",
+							Computed:            true,
+						},
+						"media_info": schema.SingleNestedAttribute{
+							MarkdownDescription: "Media info.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"video_codec": schema.StringAttribute{
+									MarkdownDescription: "Video codec.",
+									Computed:            true,
+								},
+								"video_bit_depth": schema.Int64Attribute{
+									MarkdownDescription: "Video bit depth.",
+									Computed:            true,
+								},
+								"video_bitrate": schema.Int64Attribute{
+									MarkdownDescription: "Video bitrate.",
+									Computed:            true,
+								},
+								"video_fps": schema.Float64Attribute{
+									MarkdownDescription: "Video FPS.",
+									Computed:            true,
+								},
+								"video_dynamic_range": schema.StringAttribute{
+									MarkdownDescription: "Video dynamic range.",
+									Computed:            true,
+								},
+								"video_dynamic_range_type": schema.StringAttribute{
+									MarkdownDescription: "Video dynamic range type (HDR type).",
+									Computed:            true,
+								},
+								"resolution": schema.StringAttribute{
+									MarkdownDescription: "Resolution.",
+									Computed:            true,
+								},
+								"scan_type": schema.StringAttribute{
+									MarkdownDescription: "Scan type.",
+									Computed:            true,
+								},
+								"run_time": schema.StringAttribute{
+									MarkdownDescription: "Run time.",
+									Computed:            true,
+								},
+								"audio_codec": schema.StringAttribute{
+									MarkdownDescription: "Audio codec.",
+									Computed:            true,
+								},
+								"audio_channels": schema.Float64Attribute{
+									MarkdownDescription: "Audio channels.",
+									Computed:            true,
+								},
+								"audio_bitrate": schema.Int64Attribute{
+									MarkdownDescription: "Audio bitrate.",
+									Computed:            true,
+								},
+								"audio_stream_count": schema.Int64Attribute{
+									MarkdownDescription: "Audio stream count.",
+									Computed:            true,
+								},
+								"audio_languages": schema.StringAttribute{
+									MarkdownDescription: "Audio languages.",
+									Computed:            true,
+								},
+								"subtitles": schema.StringAttribute{
+									MarkdownDescription: "Subtitles.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MovieFilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *MovieFilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *MovieFiles
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := make([]int32, 0)
+	if data.MovieIDs.IsNull() {
+		// Movie files can only be listed by movie, collect all movies with a file
+		movies, _, err := d.client.MovieAPI.ListMovie(d.auth).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, movieFilesDataSourceName, err))
+
+			return
+		}
+
+		for _, m := range movies {
+			if m.GetHasFile() {
+				ids = append(ids, m.GetId())
+			}
+		}
+	} else {
+		resp.Diagnostics.Append(data.MovieIDs.ElementsAs(ctx, &ids, false)...)
+	}
+
+	response := make([]radarr.MovieFileResource, 0)
+
+	// Get movie files current value, in batches to keep the query string short
+	for batch := range slices.Chunk(ids, movieFilesBatchSize) {
+		files, _, err := d.client.MovieFileAPI.ListMovieFile(d.auth).MovieId(batch).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, movieFilesDataSourceName, err))
+
+			return
+		}
+
+		response = append(response, files...)
+	}
+
+	tflog.Trace(ctx, "read "+movieFilesDataSourceName)
+	// Map response body to resource schema attribute
+	files := make([]MovieFile, len(response))
+	for i, f := range response {
+		files[i].write(ctx, &f, &resp.Diagnostics)
+	}
+
+	fileList, diags := types.SetValueFrom(ctx, MovieFile{}.getType(), files)
+	resp.Diagnostics.Append(diags...)
+
+	data.MovieFiles = fileList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMovieFilesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccMovieFilesDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccMovieFilesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.radarr_movie_files.test", "id"),
+				),
+			},
+			// Filtered read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccMovieResourceConfig("Alien", "Alien", 348) + testAccMovieFilesDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.radarr_movie_files.test", "movie_files.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMovieFilesDataSourceConfig = `
+data "radarr_movie_files" "test" {
+}
+`
+
+const testAccMovieFilesDataSourceFilterConfig = `
+data "radarr_movie_files" "test" {
+	movie_ids = [radarr_movie.test.id]
+}
+`
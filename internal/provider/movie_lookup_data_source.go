@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const movieLookupDataSourceName = "movie_lookup"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = &MovieLookupDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &MovieLookupDataSource{}
+)
+
+func NewMovieLookupDataSource() datasource.DataSource {
+	return &MovieLookupDataSource{}
+}
+
+// MovieLookupDataSource defines the movie lookup implementation.
+type MovieLookupDataSource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// MovieLookup describes the movie lookup data model.
+type MovieLookup struct {
+	Movies types.Set    `tfsdk:"movies"`
+	ID     types.String `tfsdk:"id"`
+	Term   types.String `tfsdk:"term"`
+	IMDBID types.String `tfsdk:"imdb_id"`
+	TMDBID types.Int64  `tfsdk:"tmdb_id"`
+}
+
+// MovieLookupResult is part of MovieLookup.
+type MovieLookupResult struct {
+	Genres        types.Set    `tfsdk:"genres"`
+	Title         types.String `tfsdk:"title"`
+	OriginalTitle types.String `tfsdk:"original_title"`
+	IMDBID        types.String `tfsdk:"imdb_id"`
+	Studio        types.String `tfsdk:"studio"`
+	Certification types.String `tfsdk:"certification"`
+	Status        types.String `tfsdk:"status"`
+	Overview      types.String `tfsdk:"overview"`
+	TMDBID        types.Int64  `tfsdk:"tmdb_id"`
+	Year          types.Int64  `tfsdk:"year"`
+	Runtime       types.Int64  `tfsdk:"runtime"`
+	ID            types.Int64  `tfsdk:"id"`
+	InLibrary     types.Bool   `tfsdk:"in_library"`
+}
+
+func (m MovieLookupResult) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"genres":         types.SetType{}.WithElementType(types.StringType),
+			"title":          types.StringType,
+			"original_title": types.StringType,
+			"imdb_id":        types.StringType,
+			"studio":         types.StringType,
+			"certification":  types.StringType,
+			"status":         types.StringType,
+			"overview":       types.StringType,
+			"tmdb_id":        types.Int64Type,
+			"year":           types.Int64Type,
+			"runtime":        types.Int64Type,
+			"id":             types.Int64Type,
+			"in_library":     types.BoolType,
+		})
+}
+
+func (d *MovieLookupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + movieLookupDataSourceName
+}
+
+func (d *MovieLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nSearch candidate movies to be used in [Movie](../resources/movie).\nExactly one of `term`, `tmdb_id` or `imdb_id` must be set.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"term": schema.StringAttribute{
+				MarkdownDescription: "Search term.",
+				Optional:            true,
+			},
+			"tmdb_id": schema.Int64Attribute{
+				MarkdownDescription: "TMDB ID.",
+				Optional:            true,
+			},
+			"imdb_id": schema.StringAttribute{
+				MarkdownDescription: "IMDB ID.",
+				Optional:            true,
+			},
+			"movies": schema.SetNestedAttribute{
+				MarkdownDescription: "Candidate movie list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Movie title.",
+							Computed:            true,
+						},
+						"original_title": schema.StringAttribute{
+							MarkdownDescription: "Movie original title.",
+							Computed:            true,
+						},
+						"year": schema.Int64Attribute{
+							MarkdownDescription: "Year.",
+							Computed:            true,
+						},
+						"tmdb_id": schema.Int64Attribute{
+							MarkdownDescription: "TMDB ID.",
+							Computed:            true,
+						},
+						"imdb_id": schema.StringAttribute{
+							MarkdownDescription: "IMDB ID.",
+							Computed:            true,
+						},
+						"runtime": schema.Int64Attribute{
+							MarkdownDescription: "Runtime in minutes.",
+							Computed:            true,
+						},
+						"genres": schema.SetAttribute{
+							MarkdownDescription: "List genres.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"studio": schema.StringAttribute{
+							MarkdownDescription: "Studio.",
+							Computed:            true,
+						},
+						"certification": schema.StringAttribute{
+							MarkdownDescription: "Certification.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Movie status.",
+							Computed:            true,
+						},
+						"overview": schema.StringAttribute{
+							MarkdownDescription: "Overview.",
+							Computed:            true,
+						},
+						"in_library": schema.BoolAttribute{
+							MarkdownDescription: "Movie already in library flag.",
+							Computed:            true,
+						},
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Library movie ID, `0` when the movie is not in library.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MovieLookupDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("term"),
+			path.MatchRoot("tmdb_id"),
+			path.MatchRoot("imdb_id"),
+		),
+	}
+}
+
+func (d *MovieLookupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *MovieLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *MovieLookup
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Search movies with the configured key, the ID specific endpoints return a single object
+	term := data.Term.ValueString()
+
+	switch {
+	case !data.TMDBID.IsNull():
+		term = "tmdb:" + strconv.FormatInt(data.TMDBID.ValueInt64(), 10)
+	case !data.IMDBID.IsNull():
+		term = "imdb:" + data.IMDBID.ValueString()
+	}
+
+	response, _, err := d.client.MovieLookupAPI.ListMovieLookup(d.auth).Term(term).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieLookupDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+movieLookupDataSourceName)
+	// Map response body to resource schema attribute
+	movies := make([]MovieLookupResult, len(response))
+	for i, m := range response {
+		movies[i].write(ctx, &m, &resp.Diagnostics)
+	}
+
+	movieList, diags := types.SetValueFrom(ctx, MovieLookupResult{}.getType(), movies)
+	resp.Diagnostics.Append(diags...)
+
+	data.Movies = movieList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (m *MovieLookupResult) write(ctx context.Context, movie *radarr.MovieResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	m.ID = types.Int64Value(int64(movie.GetId()))
+	m.InLibrary = types.BoolValue(movie.GetId() != 0)
+	m.Title = types.StringValue(movie.GetTitle())
+	m.OriginalTitle = types.StringValue(movie.GetOriginalTitle())
+	m.Year = types.Int64Value(int64(movie.GetYear()))
+	m.TMDBID = types.Int64Value(int64(movie.GetTmdbId()))
+	m.IMDBID = types.StringValue(movie.GetImdbId())
+	m.Runtime = types.Int64Value(int64(movie.GetRuntime()))
+	m.Studio = types.StringValue(movie.GetStudio())
+	m.Certification = types.StringValue(movie.GetCertification())
+	m.Status = types.StringValue(string(movie.GetStatus()))
+	m.Overview = types.StringValue(movie.GetOverview())
+	m.Genres, tempDiag = types.SetValueFrom(ctx, types.StringType, movie.GetGenres())
+	diags.Append(tempDiag...)
+}
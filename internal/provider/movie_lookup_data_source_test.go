@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const (
+	testMovieLookupMatrix = `{
+		"title": "The Matrix",
+		"originalTitle": "The Matrix",
+		"year": 1999,
+		"tmdbId": 603,
+		"imdbId": "tt0133093",
+		"runtime": 136,
+		"genres": ["Action", "Science Fiction"],
+		"studio": "Village Roadshow Pictures",
+		"certification": "R",
+		"status": "released",
+		"id": 5
+	}`
+	testMovieLookupReloaded = `{
+		"title": "The Matrix Reloaded",
+		"originalTitle": "The Matrix Reloaded",
+		"year": 2003,
+		"tmdbId": 604,
+		"imdbId": "tt0234215",
+		"runtime": 138,
+		"genres": ["Action"],
+		"status": "released"
+	}`
+)
+
+// testMovieLookupServer stubs the lookup endpoints since they rely on external metadata.
+func testMovieLookupServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "StubAPIKey" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch term := r.URL.Query().Get("term"); {
+		case r.URL.Path != "/api/v3/movie/lookup":
+			// ID specific endpoints return a single object, like Radarr does
+			_, _ = w.Write([]byte(testMovieLookupMatrix))
+		case term == "tmdb:603" || term == "imdb:tt0133093":
+			_, _ = w.Write([]byte("[" + testMovieLookupMatrix + "]"))
+		case term == "matrix":
+			_, _ = w.Write([]byte("[" + testMovieLookupMatrix + "," + testMovieLookupReloaded + "]"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestAccMovieLookupDataSource(t *testing.T) {
+	t.Parallel()
+
+	server := testMovieLookupServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccMovieLookupDataSourceConfig(server.URL, "ErrorAPIKey", `term = "matrix"`),
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid search key
+			{
+				Config:      testAccMovieLookupDataSourceConfig(server.URL, "StubAPIKey", `term = "matrix"`+"\n"+`tmdb_id = 603`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read by term testing
+			{
+				Config: testAccMovieLookupDataSourceConfig(server.URL, "StubAPIKey", `term = "matrix"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.radarr_movie_lookup.test", "movies.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.radarr_movie_lookup.test", "movies.*", map[string]string{"tmdb_id": "603", "in_library": "true", "runtime": "136"}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.radarr_movie_lookup.test", "movies.*", map[string]string{"tmdb_id": "604", "in_library": "false"}),
+				),
+			},
+			// Read by TMDB ID testing
+			{
+				Config: testAccMovieLookupDataSourceConfig(server.URL, "StubAPIKey", `tmdb_id = 603`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.radarr_movie_lookup.test", "movies.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.radarr_movie_lookup.test", "movies.*", map[string]string{"imdb_id": "tt0133093", "studio": "Village Roadshow Pictures", "certification": "R"}),
+				),
+			},
+			// Read by IMDB ID testing
+			{
+				Config: testAccMovieLookupDataSourceConfig(server.URL, "StubAPIKey", `imdb_id = "tt0133093"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.radarr_movie_lookup.test", "movies.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.radarr_movie_lookup.test", "movies.*", map[string]string{"tmdb_id": "603"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccMovieLookupDataSourceConfig(url, key, search string) string {
+	return fmt.Sprintf(`
+	provider "radarr" {
+		url = "%s"
+		api_key = "%s"
+	}
+
+	data "radarr_movie_lookup" "test" {
+		%s
+	}
+	`, url, key, search)
+}
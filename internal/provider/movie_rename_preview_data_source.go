@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const movieRenamePreviewDataSourceName = "movie_rename_preview"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = &MovieRenamePreviewDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &MovieRenamePreviewDataSource{}
+)
+
+func NewMovieRenamePreviewDataSource() datasource.DataSource {
+	return &MovieRenamePreviewDataSource{}
+}
+
+// MovieRenamePreviewDataSource defines the movie rename preview implementation.
+type MovieRenamePreviewDataSource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// MovieRenamePreview describes the movie rename preview data model.
+type MovieRenamePreview struct {
+	Renames  types.Set    `tfsdk:"renames"`
+	MovieIDs types.Set    `tfsdk:"movie_ids"`
+	ID       types.String `tfsdk:"id"`
+	MovieID  types.Int64  `tfsdk:"movie_id"`
+}
+
+// MovieRename is part of MovieRenamePreview.
+type MovieRename struct {
+	ExistingPath types.String `tfsdk:"existing_path"`
+	NewPath      types.String `tfsdk:"new_path"`
+	MovieID      types.Int64  `tfsdk:"movie_id"`
+	MovieFileID  types.Int64  `tfsdk:"movie_file_id"`
+}
+
+func (r MovieRename) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"existing_path": types.StringType,
+			"new_path":      types.StringType,
+			"movie_id":      types.Int64Type,
+			"movie_file_id": types.Int64Type,
+		})
+}
+
+func (d *MovieRenamePreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + movieRenamePreviewDataSourceName
+}
+
+func (d *MovieRenamePreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nList the movie files that would be renamed according to the current [Naming](../resources/naming).",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie ID.",
+				Optional:            true,
+			},
+			"movie_ids": schema.SetAttribute{
+				MarkdownDescription: "Movie IDs. Each movie is checked with its own request, list only the needed ones.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"renames": schema.SetNestedAttribute{
+				MarkdownDescription: "Rename list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"movie_id": schema.Int64Attribute{
+							MarkdownDescription: "Movie ID.",
+							Computed:            true,
+						},
+						"movie_file_id": schema.Int64Attribute{
+							MarkdownDescription: "Movie file ID.",
+							Computed:            true,
+						},
+						"existing_path": schema.StringAttribute{
+							MarkdownDescription: "Existing path relative to the movie folder.",
+							Computed:            true,
+						},
+						"new_path": schema.StringAttribute{
+							MarkdownDescription: "New path relative to the movie folder.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MovieRenamePreviewDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("movie_id"),
+			path.MatchRoot("movie_ids"),
+		),
+	}
+}
+
+func (d *MovieRenamePreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *MovieRenamePreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *MovieRenamePreview
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := make([]int32, 0)
+	if !data.MovieID.IsNull() {
+		ids = append(ids, int32(data.MovieID.ValueInt64()))
+	}
+
+	resp.Diagnostics.Append(data.MovieIDs.ElementsAs(ctx, &ids, true)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get renames current value
+	response, err := listMovieRenames(d.auth, d.client, ids)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, movieRenamePreviewDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+movieRenamePreviewDataSourceName)
+	// Map response body to resource schema attribute
+	renames := make([]MovieRename, len(response))
+	for i, r := range response {
+		renames[i].write(&r)
+	}
+
+	renameList, diags := types.SetValueFrom(ctx, MovieRename{}.getType(), renames)
+	resp.Diagnostics.Append(diags...)
+
+	data.Renames = renameList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listMovieRenames returns the pending renames of the given movies, Radarr only lists them one movie at a time.
+func listMovieRenames(auth context.Context, client *radarr.APIClient, ids []int32) ([]radarr.RenameMovieResource, error) {
+	renames := make([]radarr.RenameMovieResource, 0)
+
+	for _, id := range ids {
+		response, _, err := client.RenameMovieAPI.ListRename(auth).MovieId(id).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		renames = append(renames, response...)
+	}
+
+	return renames, nil
+}
+
+// listMovieIDsWithFile returns the IDs of all the movies with a file, the only ones that can be renamed.
+func listMovieIDsWithFile(auth context.Context, client *radarr.APIClient) ([]int32, error) {
+	movies, _, err := client.MovieAPI.ListMovie(auth).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int32, 0, len(movies))
+
+	for _, m := range movies {
+		if m.GetHasFile() {
+			ids = append(ids, m.GetId())
+		}
+	}
+
+	return ids, nil
+}
+
+func (r *MovieRename) write(rename *radarr.RenameMovieResource) {
+	r.MovieID = types.Int64Value(int64(rename.GetMovieId()))
+	r.MovieFileID = types.Int64Value(int64(rename.GetMovieFileId()))
+	r.ExistingPath = types.StringValue(rename.GetExistingPath())
+	r.NewPath = types.StringValue(rename.GetNewPath())
+}
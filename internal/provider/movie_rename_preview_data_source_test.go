@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMovieRenamePreviewDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccMovieRenamePreviewDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Missing movies
+			{
+				Config:      testAccMovieRenamePreviewDataSourceEmptyConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccMovieResourceConfig("Jaws", "Jaws", 578) + testAccMovieRenamePreviewDataSourceMoviesConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.radarr_movie_rename_preview.test", "id"),
+					resource.TestCheckResourceAttr("data.radarr_movie_rename_preview.test", "renames.#", "0"),
+				),
+			},
+			// Single movie testing
+			{
+				Config: testAccMovieResourceConfig("Jaws", "Jaws", 578) + testAccMovieRenamePreviewDataSourceMovieConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.radarr_movie_rename_preview.test", "renames.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMovieRenamePreviewDataSourceEmptyConfig = `
+data "radarr_movie_rename_preview" "test" {
+}
+`
+
+const testAccMovieRenamePreviewDataSourceConfig = `
+data "radarr_movie_rename_preview" "test" {
+	movie_ids = [1]
+}
+`
+
+const testAccMovieRenamePreviewDataSourceMoviesConfig = `
+data "radarr_movie_rename_preview" "test" {
+	movie_ids = [radarr_movie.test.id]
+}
+`
+
+const testAccMovieRenamePreviewDataSourceMovieConfig = `
+data "radarr_movie_rename_preview" "test" {
+	movie_id = radarr_movie.test.id
+}
+`
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	movieRenameResourceName = "movie_rename"
+	movieRenameTimeout      = 10 * time.Minute
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MovieRenameResource{}
+
+func NewMovieRenameResource() resource.Resource {
+	return &MovieRenameResource{}
+}
+
+// MovieRenameResource defines the movie rename implementation.
+type MovieRenameResource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// MovieRenameAction describes the movie rename data model.
+type MovieRenameAction struct {
+	Triggers types.Map   `tfsdk:"triggers"`
+	Renames  types.Set   `tfsdk:"renames"`
+	MovieID  types.Int64 `tfsdk:"movie_id"`
+}
+
+func (r *MovieRenameResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + movieRenameResourceName
+}
+
+func (r *MovieRenameResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Movies -->\nMovie Rename resource.\nRenames the movie files according to the current [Naming](naming) on create and whenever `triggers` change, waiting for the rename to complete.\nUse a hash of the naming configuration as trigger to follow naming changes in the same apply. Destroying it does not revert the names.",
+		Attributes: map[string]schema.Attribute{
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that trigger a new rename when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"movie_id": schema.Int64Attribute{
+				MarkdownDescription: "Movie ID to rename. Defaults to all movies, checking each movie with a file through its own request whenever a rename runs, which is slow on big libraries.",
+				Optional:            true,
+			},
+			"renames": schema.SetNestedAttribute{
+				MarkdownDescription: "Renames applied by the last run.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"movie_id": schema.Int64Attribute{
+							MarkdownDescription: "Movie ID.",
+							Computed:            true,
+						},
+						"movie_file_id": schema.Int64Attribute{
+							MarkdownDescription: "Movie file ID.",
+							Computed:            true,
+						},
+						"existing_path": schema.StringAttribute{
+							MarkdownDescription: "Previous path relative to the movie folder.",
+							Computed:            true,
+						},
+						"new_path": schema.StringAttribute{
+							MarkdownDescription: "New path relative to the movie folder.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *MovieRenameResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *MovieRenameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var rename *MovieRenameAction
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &rename)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.rename(ctx, rename, helpers.Create, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+movieRenameResourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &rename)...)
+}
+
+func (r *MovieRenameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Rename is an action, keep the last run result
+	var rename *MovieRenameAction
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &rename)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+movieRenameResourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &rename)...)
+}
+
+func (r *MovieRenameResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var rename *MovieRenameAction
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &rename)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.rename(ctx, rename, helpers.Update, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+movieRenameResourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &rename)...)
+}
+
+func (r *MovieRenameResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Rename cannot be reverted just removing configuration
+	tflog.Trace(ctx, "decoupled "+movieRenameResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// rename runs the rename command for the pending renames and waits for its completion.
+func (r *MovieRenameResource) rename(ctx context.Context, rename *MovieRenameAction, action string, diags *diag.Diagnostics) {
+	ids := []int32{int32(rename.MovieID.ValueInt64())}
+
+	if rename.MovieID.IsNull() {
+		var err error
+
+		if ids, err = listMovieIDsWithFile(r.auth, r.client); err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(action, movieRenameResourceName, err))
+
+			return
+		}
+	}
+
+	response, err := listMovieRenames(r.auth, r.client, ids)
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, movieRenameResourceName, err))
+
+		return
+	}
+
+	if len(response) > 0 {
+		name, params := rename.command(response)
+
+		command, err := helpers.CreateCommand(r.auth, r.client, name, params)
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(action, movieRenameResourceName, err))
+
+			return
+		}
+
+		if _, err := helpers.WaitCommand(helpers.WithAuth(ctx, r.auth), r.client, command.GetId(), movieRenameTimeout, helpers.CommandPollInterval); err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(action, movieRenameResourceName, err))
+
+			return
+		}
+	}
+
+	renames := make([]MovieRename, len(response))
+	for i, m := range response {
+		renames[i].write(&m)
+	}
+
+	var tempDiag diag.Diagnostics
+
+	rename.Renames, tempDiag = types.SetValueFrom(ctx, MovieRename{}.getType(), renames)
+	diags.Append(tempDiag...)
+}
+
+// command returns RenameFiles for a single movie, RenameMovie for all the movies with pending renames.
+func (m *MovieRenameAction) command(renames []radarr.RenameMovieResource) (string, map[string]interface{}) {
+	if !m.MovieID.IsNull() {
+		files := make([]int32, len(renames))
+		for i, r := range renames {
+			files[i] = r.GetMovieFileId()
+		}
+
+		return "RenameFiles", map[string]interface{}{"movieId": m.MovieID.ValueInt64(), "files": files}
+	}
+
+	movies := make([]int32, 0)
+
+	for _, r := range renames {
+		if !slices.Contains(movies, r.GetMovieId()) {
+			movies = append(movies, r.GetMovieId())
+		}
+	}
+
+	return "RenameMovie", map[string]interface{}{"movieIds": movies}
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMovieRenameResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccMovieRenameResourceConfig("first") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccMovieRenameResourceConfig("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_movie_rename.test", "triggers.naming", "first"),
+					resource.TestCheckResourceAttr("radarr_movie_rename.test", "renames.#", "0"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccMovieRenameResourceConfig("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_movie_rename.test", "triggers.naming", "second"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccMovieRenameResourceConfig(trigger string) string {
+	return testAccMovieResourceConfig("Jurassic Park", "Jurassic", 329) + fmt.Sprintf(`
+		resource "radarr_movie_rename" "test" {
+			movie_id = radarr_movie.test.id
+			triggers = {
+				naming = "%s"
+			}
+		}
+	`, trigger)
+}
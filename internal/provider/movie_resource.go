@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -26,6 +27,7 @@ const movieResourceName = "movie"
 var (
 	_ resource.Resource                = &MovieResource{}
 	_ resource.ResourceWithImportState = &MovieResource{}
+	_ resource.ResourceWithModifyPlan  = &MovieResource{}
 )
 
 func NewMovieResource() resource.Resource {
@@ -40,44 +42,55 @@ type MovieResource struct {
 
 // Movie describes the movie data model.
 type Movie struct {
-	Genres              types.Set    `tfsdk:"genres"`
-	Tags                types.Set    `tfsdk:"tags"`
-	OriginalLanguage    types.Object `tfsdk:"original_language"`
-	Title               types.String `tfsdk:"title"`
-	Path                types.String `tfsdk:"path"`
-	MinimumAvailability types.String `tfsdk:"minimum_availability"`
-	OriginalTitle       types.String `tfsdk:"original_title"`
-	Status              types.String `tfsdk:"status"`
-	IMDBID              types.String `tfsdk:"imdb_id"`
-	YouTubeTrailerID    types.String `tfsdk:"youtube_trailer_id"`
-	Overview            types.String `tfsdk:"overview"`
-	Website             types.String `tfsdk:"website"`
-	ID                  types.Int64  `tfsdk:"id"`
-	QualityProfileID    types.Int64  `tfsdk:"quality_profile_id"`
-	TMDBID              types.Int64  `tfsdk:"tmdb_id"`
-	Year                types.Int64  `tfsdk:"year"`
-	IsAvailable         types.Bool   `tfsdk:"is_available"`
-	Monitored           types.Bool   `tfsdk:"monitored"`
+	Genres              types.Set     `tfsdk:"genres"`
+	Tags                types.Set     `tfsdk:"tags"`
+	Images              types.Set     `tfsdk:"images"`
+	OriginalLanguage    types.Object  `tfsdk:"original_language"`
+	Ratings             types.Object  `tfsdk:"ratings"`
+	MovieFile           types.Object  `tfsdk:"movie_file"`
+	Collection          types.Object  `tfsdk:"collection"`
+	Title               types.String  `tfsdk:"title"`
+	Path                types.String  `tfsdk:"path"`
+	MinimumAvailability types.String  `tfsdk:"minimum_availability"`
+	OriginalTitle       types.String  `tfsdk:"original_title"`
+	Status              types.String  `tfsdk:"status"`
+	IMDBID              types.String  `tfsdk:"imdb_id"`
+	YouTubeTrailerID    types.String  `tfsdk:"youtube_trailer_id"`
+	Overview            types.String  `tfsdk:"overview"`
+	Website             types.String  `tfsdk:"website"`
+	Studio              types.String  `tfsdk:"studio"`
+	Certification       types.String  `tfsdk:"certification"`
+	Added               types.String  `tfsdk:"added"`
+	InCinemas           types.String  `tfsdk:"in_cinemas"`
+	DigitalRelease      types.String  `tfsdk:"digital_release"`
+	PhysicalRelease     types.String  `tfsdk:"physical_release"`
+	LastSearchTime      types.String  `tfsdk:"last_search_time"`
+	ID                  types.Int64   `tfsdk:"id"`
+	QualityProfileID    types.Int64   `tfsdk:"quality_profile_id"`
+	TMDBID              types.Int64   `tfsdk:"tmdb_id"`
+	Year                types.Int64   `tfsdk:"year"`
+	SizeOnDisk          types.Int64   `tfsdk:"size_on_disk"`
+	Runtime             types.Int64   `tfsdk:"runtime"`
+	Popularity          types.Float64 `tfsdk:"popularity"`
+	IsAvailable         types.Bool    `tfsdk:"is_available"`
+	Monitored           types.Bool    `tfsdk:"monitored"`
+	HasFile             types.Bool    `tfsdk:"has_file"`
 
 	// TODO: future Implementation
 	// SortTitle      types.String  `tfsdk:"sortTitle"`
-	// SizeOnDisk     types.Int64   `tfsdk:"sizeOnDisk"`
 	// RemotePoster   types.String  `tfsdk:"remotePoster"`
-	// HasFile        types.Bool    `tfsdk:"hasFile"`
-	// Studio         types.String  `tfsdk:"studio"`
 	// RootFolderPath types.String  `tfsdk:"root_folder_path"`
 	// FolderName     types.String  `tfsdk:"folderName"`
-	// Runtime        types.Int64   `tfsdk:"runtime"`
 	// CleanTitle     types.String  `tfsdk:"cleanTitle"`
 	// TitleSlug      types.String  `tfsdk:"titleSlug"`
 	// Folder         types.String  `tfsdk:"folder"`
-	// Certification  types.String  `tfsdk:"certification"`
-	// Added          types.String  `tfsdk:"added"`
-	// Popularity     types.Float64 `tfsdk:"popularity"`
-	// Images         types.Set     `tfsdk:"images"`
-	// Ratings        types.Object  `tfsdk:"ratings"`
-	// MovieFile      types.Object  `tfsdk:"movieFile"`
-	// Collection     types.Object  `tfsdk:"collection"`
+}
+
+// MovieManaged describes the movie resource data model.
+// It extends Movie with the options that only apply to the resource.
+type MovieManaged struct {
+	Movie
+	MoveFilesOnPathChange types.Bool `tfsdk:"move_files_on_path_change"`
 }
 
 func (m Movie) getType() attr.Type {
@@ -85,7 +98,11 @@ func (m Movie) getType() attr.Type {
 		map[string]attr.Type{
 			"genres":               types.SetType{}.WithElementType(types.StringType),
 			"tags":                 types.SetType{}.WithElementType(types.Int64Type),
+			"images":               types.SetType{}.WithElementType(MovieImage{}.getType()),
 			"original_language":    QualityLanguage{}.getType(),
+			"ratings":              MovieRatings{}.getType(),
+			"movie_file":           MovieFileSummary{}.getType(),
+			"collection":           MovieCollection{}.getType(),
 			"title":                types.StringType,
 			"path":                 types.StringType,
 			"minimum_availability": types.StringType,
@@ -95,12 +112,113 @@ func (m Movie) getType() attr.Type {
 			"youtube_trailer_id":   types.StringType,
 			"overview":             types.StringType,
 			"website":              types.StringType,
+			"studio":               types.StringType,
+			"certification":        types.StringType,
+			"added":                types.StringType,
+			"in_cinemas":           types.StringType,
+			"digital_release":      types.StringType,
+			"physical_release":     types.StringType,
+			"last_search_time":     types.StringType,
 			"id":                   types.Int64Type,
 			"quality_profile_id":   types.Int64Type,
 			"tmdb_id":              types.Int64Type,
 			"year":                 types.Int64Type,
+			"size_on_disk":         types.Int64Type,
+			"runtime":              types.Int64Type,
+			"popularity":           types.Float64Type,
 			"is_available":         types.BoolType,
 			"monitored":            types.BoolType,
+			"has_file":             types.BoolType,
+		})
+}
+
+// MovieImage is part of Movie.
+type MovieImage struct {
+	CoverType types.String `tfsdk:"cover_type"`
+	URL       types.String `tfsdk:"url"`
+	RemoteURL types.String `tfsdk:"remote_url"`
+}
+
+func (i MovieImage) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"cover_type": types.StringType,
+			"url":        types.StringType,
+			"remote_url": types.StringType,
+		})
+}
+
+// MovieRatings is part of Movie.
+type MovieRatings struct {
+	IMDB           types.Object `tfsdk:"imdb"`
+	TMDB           types.Object `tfsdk:"tmdb"`
+	Metacritic     types.Object `tfsdk:"metacritic"`
+	RottenTomatoes types.Object `tfsdk:"rotten_tomatoes"`
+	Trakt          types.Object `tfsdk:"trakt"`
+}
+
+func (r MovieRatings) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"imdb":            MovieRating{}.getType(),
+			"tmdb":            MovieRating{}.getType(),
+			"metacritic":      MovieRating{}.getType(),
+			"rotten_tomatoes": MovieRating{}.getType(),
+			"trakt":           MovieRating{}.getType(),
+		})
+}
+
+// MovieRating is part of MovieRatings.
+type MovieRating struct {
+	Type  types.String  `tfsdk:"type"`
+	Votes types.Int64   `tfsdk:"votes"`
+	Value types.Float64 `tfsdk:"value"`
+}
+
+func (r MovieRating) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"type":  types.StringType,
+			"votes": types.Int64Type,
+			"value": types.Float64Type,
+		})
+}
+
+// MovieFileSummary is part of Movie.
+type MovieFileSummary struct {
+	RelativePath        types.String `tfsdk:"relative_path"`
+	Quality             types.String `tfsdk:"quality"`
+	DateAdded           types.String `tfsdk:"date_added"`
+	ID                  types.Int64  `tfsdk:"id"`
+	Size                types.Int64  `tfsdk:"size"`
+	CustomFormatScore   types.Int64  `tfsdk:"custom_format_score"`
+	QualityCutoffNotMet types.Bool   `tfsdk:"quality_cutoff_not_met"`
+}
+
+func (f MovieFileSummary) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"relative_path":          types.StringType,
+			"quality":                types.StringType,
+			"date_added":             types.StringType,
+			"id":                     types.Int64Type,
+			"size":                   types.Int64Type,
+			"custom_format_score":    types.Int64Type,
+			"quality_cutoff_not_met": types.BoolType,
+		})
+}
+
+// MovieCollection is part of Movie.
+type MovieCollection struct {
+	Title  types.String `tfsdk:"title"`
+	TMDBID types.Int64  `tfsdk:"tmdb_id"`
+}
+
+func (c MovieCollection) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"title":   types.StringType,
+			"tmdb_id": types.Int64Type,
 		})
 }
 
@@ -147,6 +265,12 @@ func (r *MovieResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				MarkdownDescription: "Full movie path.",
 				Required:            true,
 			},
+			"move_files_on_path_change": schema.BoolAttribute{
+				MarkdownDescription: "Move the movie files on disk when `path` changes. If false, only the path stored in Radarr is updated.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
 			"minimum_availability": schema.StringAttribute{
 				MarkdownDescription: "Minimum availability.\nAllowed values: 'tba', 'announced', 'inCinemas', 'released', 'deleted'.",
 				Optional:            true,
@@ -195,6 +319,168 @@ func (r *MovieResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:            true,
 				Attributes:          QualityProfileResource{}.getQualityLanguageSchema().Attributes,
 			},
+			"has_file": schema.BoolAttribute{
+				MarkdownDescription: "Has file flag.",
+				Computed:            true,
+			},
+			"size_on_disk": schema.Int64Attribute{
+				MarkdownDescription: "Size on disk in bytes.",
+				Computed:            true,
+			},
+			"runtime": schema.Int64Attribute{
+				MarkdownDescription: "Runtime in minutes.",
+				Computed:            true,
+			},
+			"popularity": schema.Float64Attribute{
+				MarkdownDescription: "Popularity.",
+				Computed:            true,
+			},
+			"studio": schema.StringAttribute{
+				MarkdownDescription: "Studio.",
+				Computed:            true,
+			},
+			"certification": schema.StringAttribute{
+				MarkdownDescription: "Certification.",
+				Computed:            true,
+			},
+			"added": schema.StringAttribute{
+				MarkdownDescription: "Date the movie was added to Radarr, in RFC3339 format.",
+				Computed:            true,
+			},
+			"in_cinemas": schema.StringAttribute{
+				MarkdownDescription: "In cinemas release date, in RFC3339 format.",
+				Computed:            true,
+			},
+			"digital_release": schema.StringAttribute{
+				MarkdownDescription: "Digital release date, in RFC3339 format.",
+				Computed:            true,
+			},
+			"physical_release": schema.StringAttribute{
+				MarkdownDescription: "Physical release date, in RFC3339 format.",
+				Computed:            true,
+			},
+			"last_search_time": schema.StringAttribute{
+				MarkdownDescription: "Last automatic search time, in RFC3339 format.",
+				Computed:            true,
+			},
+			"images": schema.SetNestedAttribute{
+				MarkdownDescription: "Images.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cover_type": schema.StringAttribute{
+							MarkdownDescription: "Cover type.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "Local URL.",
+							Computed:            true,
+						},
+						"remote_url": schema.StringAttribute{
+							MarkdownDescription: "Remote URL.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ratings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Ratings.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"imdb": schema.SingleNestedAttribute{
+						MarkdownDescription: "IMDB rating.",
+						Computed:            true,
+						Attributes:          r.getRatingSchema().Attributes,
+					},
+					"tmdb": schema.SingleNestedAttribute{
+						MarkdownDescription: "TMDB rating.",
+						Computed:            true,
+						Attributes:          r.getRatingSchema().Attributes,
+					},
+					"metacritic": schema.SingleNestedAttribute{
+						MarkdownDescription: "Metacritic rating.",
+						Computed:            true,
+						Attributes:          r.getRatingSchema().Attributes,
+					},
+					"rotten_tomatoes": schema.SingleNestedAttribute{
+						MarkdownDescription: "Rotten Tomatoes rating.",
+						Computed:            true,
+						Attributes:          r.getRatingSchema().Attributes,
+					},
+					"trakt": schema.SingleNestedAttribute{
+						MarkdownDescription: "Trakt rating.",
+						Computed:            true,
+						Attributes:          r.getRatingSchema().Attributes,
+					},
+				},
+			},
+			"movie_file": schema.SingleNestedAttribute{
+				MarkdownDescription: "Movie file. Null if the movie has no file.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						MarkdownDescription: "Movie file ID.",
+						Computed:            true,
+					},
+					"relative_path": schema.StringAttribute{
+						MarkdownDescription: "Path relative to the movie folder.",
+						Computed:            true,
+					},
+					"size": schema.Int64Attribute{
+						MarkdownDescription: "Size in bytes.",
+						Computed:            true,
+					},
+					"date_added": schema.StringAttribute{
+						MarkdownDescription: "Date the file was added, in RFC3339 format.",
+						Computed:            true,
+					},
+					"quality": schema.StringAttribute{
+						MarkdownDescription: "Quality name.",
+						Computed:            true,
+					},
+					"custom_format_score": schema.Int64Attribute{
+						MarkdownDescription: "Custom format score.",
+						Computed:            true,
+					},
+					"quality_cutoff_not_met": schema.BoolAttribute{
+						MarkdownDescription: "Quality cutoff not met flag.",
+						Computed:            true,
+					},
+				},
+			},
+			"collection": schema.SingleNestedAttribute{
+				MarkdownDescription: "Collection the movie belongs to. Null if the movie is not part of a collection.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						MarkdownDescription: "Collection title.",
+						Computed:            true,
+					},
+					"tmdb_id": schema.Int64Attribute{
+						MarkdownDescription: "Collection TMDB ID.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r MovieResource) getRatingSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Rating type.",
+				Computed:            true,
+			},
+			"votes": schema.Int64Attribute{
+				MarkdownDescription: "Number of votes.",
+				Computed:            true,
+			},
+			"value": schema.Float64Attribute{
+				MarkdownDescription: "Rating value.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -208,7 +494,7 @@ func (r *MovieResource) Configure(ctx context.Context, req resource.ConfigureReq
 
 func (r *MovieResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var movie *Movie
+	var movie *MovieManaged
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &movie)...)
 
@@ -238,7 +524,7 @@ func (r *MovieResource) Create(ctx context.Context, req resource.CreateRequest,
 
 func (r *MovieResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var movie *Movie
+	var movie *MovieManaged
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &movie)...)
 
@@ -257,12 +543,18 @@ func (r *MovieResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	tflog.Trace(ctx, "read "+movieResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	movie.write(ctx, response, &resp.Diagnostics)
+
+	// Default is not applied on import
+	if movie.MoveFilesOnPathChange.IsNull() {
+		movie.MoveFilesOnPathChange = types.BoolValue(true)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &movie)...)
 }
 
 func (r *MovieResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var movie *Movie
+	var movie *MovieManaged
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &movie)...)
 
@@ -273,7 +565,7 @@ func (r *MovieResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Update Movie
 	request := movie.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MovieAPI.UpdateMovie(r.auth, fmt.Sprint(request.GetId())).MovieResource(*request).Execute()
+	response, _, err := r.client.MovieAPI.UpdateMovie(r.auth, fmt.Sprint(request.GetId())).MoveFiles(movie.MoveFilesOnPathChange.ValueBool()).MovieResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, movieResourceName, err))
 
@@ -307,6 +599,33 @@ func (r *MovieResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *MovieResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan *MovieManaged
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Path.IsUnknown() || plan.Path.Equal(state.Path) || !plan.MoveFilesOnPathChange.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("path"),
+		"Movie files will be moved",
+		fmt.Sprintf("Changing %s path from '%s' to '%s' will move its files on disk. Set `move_files_on_path_change` to false to only update the path stored in Radarr.",
+			movieResourceName, state.Path.ValueString(), plan.Path.ValueString()),
+	)
+}
+
 func (r *MovieResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
 	tflog.Trace(ctx, "imported "+movieResourceName+": "+req.ID)
@@ -330,6 +649,17 @@ func (m *Movie) write(ctx context.Context, movie *radarr.MovieResource, diags *d
 	m.YouTubeTrailerID = types.StringValue(movie.GetYouTubeTrailerId())
 	m.Overview = types.StringValue(movie.GetOverview())
 	m.Website = types.StringValue(movie.GetWebsite())
+	m.Studio = types.StringValue(movie.GetStudio())
+	m.Certification = types.StringValue(movie.GetCertification())
+	m.Added = helpers.TimeValue(movie.Added)
+	m.InCinemas = helpers.TimeValue(movie.InCinemas.Get())
+	m.DigitalRelease = helpers.TimeValue(movie.DigitalRelease.Get())
+	m.PhysicalRelease = helpers.TimeValue(movie.PhysicalRelease.Get())
+	m.LastSearchTime = helpers.TimeValue(movie.LastSearchTime.Get())
+	m.SizeOnDisk = types.Int64Value(movie.GetSizeOnDisk())
+	m.Runtime = types.Int64Value(int64(movie.GetRuntime()))
+	m.Popularity = types.Float64Value(float64(movie.GetPopularity()))
+	m.HasFile = types.BoolValue(movie.GetHasFile())
 	language := QualityLanguage{}
 	language.write(movie.OriginalLanguage)
 	m.OriginalLanguage, tempDiag = types.ObjectValueFrom(ctx, QualityLanguage{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes(), language)
@@ -338,6 +668,80 @@ func (m *Movie) write(ctx context.Context, movie *radarr.MovieResource, diags *d
 	diags.Append(tempDiag...)
 	m.Tags, tempDiag = types.SetValueFrom(ctx, types.Int64Type, movie.GetTags())
 	diags.Append(tempDiag...)
+
+	images := make([]MovieImage, len(movie.GetImages()))
+	for i, image := range movie.GetImages() {
+		images[i].write(&image)
+	}
+
+	m.Images, tempDiag = types.SetValueFrom(ctx, MovieImage{}.getType(), images)
+	diags.Append(tempDiag...)
+
+	ratings := MovieRatings{}
+	ratings.write(ctx, movie.GetRatings(), diags)
+	m.Ratings, tempDiag = types.ObjectValueFrom(ctx, MovieRatings{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes(), ratings)
+	diags.Append(tempDiag...)
+
+	m.MovieFile = types.ObjectNull(MovieFileSummary{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes())
+	if movie.MovieFile != nil {
+		file := MovieFileSummary{}
+		file.write(movie.MovieFile)
+		m.MovieFile, tempDiag = types.ObjectValueFrom(ctx, MovieFileSummary{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes(), file)
+		diags.Append(tempDiag...)
+	}
+
+	m.Collection = types.ObjectNull(MovieCollection{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes())
+	if movie.Collection != nil {
+		collection := MovieCollection{}
+		collection.write(movie.Collection)
+		m.Collection, tempDiag = types.ObjectValueFrom(ctx, MovieCollection{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes(), collection)
+		diags.Append(tempDiag...)
+	}
+}
+
+func (i *MovieImage) write(image *radarr.MediaCover) {
+	i.CoverType = types.StringValue(string(image.GetCoverType()))
+	i.URL = types.StringValue(image.GetUrl())
+	i.RemoteURL = types.StringValue(image.GetRemoteUrl())
+}
+
+func (r *MovieRatings) write(ctx context.Context, ratings radarr.Ratings, diags *diag.Diagnostics) {
+	r.IMDB = MovieRating{}.object(ctx, ratings.Imdb, diags)
+	r.TMDB = MovieRating{}.object(ctx, ratings.Tmdb, diags)
+	r.Metacritic = MovieRating{}.object(ctx, ratings.Metacritic, diags)
+	r.RottenTomatoes = MovieRating{}.object(ctx, ratings.RottenTomatoes, diags)
+	r.Trakt = MovieRating{}.object(ctx, ratings.Trakt, diags)
+}
+
+// object returns the rating as object value, null if the rating source is not available.
+func (r MovieRating) object(ctx context.Context, rating *radarr.RatingChild, diags *diag.Diagnostics) types.Object {
+	if rating == nil {
+		return types.ObjectNull(r.getType().(attr.TypeWithAttributeTypes).AttributeTypes())
+	}
+
+	r.Type = types.StringValue(string(rating.GetType()))
+	r.Votes = types.Int64Value(int64(rating.GetVotes()))
+	r.Value = types.Float64Value(rating.GetValue())
+
+	object, tempDiag := types.ObjectValueFrom(ctx, r.getType().(attr.TypeWithAttributeTypes).AttributeTypes(), r)
+	diags.Append(tempDiag...)
+
+	return object
+}
+
+func (f *MovieFileSummary) write(file *radarr.MovieFileResource) {
+	f.ID = types.Int64Value(int64(file.GetId()))
+	f.RelativePath = types.StringValue(file.GetRelativePath())
+	f.Size = types.Int64Value(file.GetSize())
+	f.DateAdded = helpers.TimeValue(file.DateAdded)
+	f.Quality = types.StringValue(file.GetQuality().Quality.GetName())
+	f.CustomFormatScore = types.Int64Value(int64(file.GetCustomFormatScore()))
+	f.QualityCutoffNotMet = types.BoolValue(file.GetQualityCutoffNotMet())
+}
+
+func (c *MovieCollection) write(collection *radarr.MovieCollectionResource) {
+	c.Title = types.StringValue(collection.GetTitle())
+	c.TMDBID = types.Int64Value(int64(collection.GetTmdbId()))
 }
 
 func (m *Movie) read(ctx context.Context, diags *diag.Diagnostics) *radarr.MovieResource {
@@ -37,6 +37,16 @@ func TestAccMovieResource(t *testing.T) {
 					resource.TestCheckResourceAttr("radarr_movie.test", "original_language.id", "1"),
 					resource.TestCheckResourceAttr("radarr_movie.test", "original_language.name", "English"),
 					resource.TestCheckResourceAttr("radarr_movie.test", "genres.0", "Action"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "has_file", "false"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "size_on_disk", "0"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "runtime", "136"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "collection.title", "The Matrix Collection"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "collection.tmdb_id", "2344"),
+					resource.TestCheckNoResourceAttr("radarr_movie.test", "movie_file.id"),
+					resource.TestCheckResourceAttrSet("radarr_movie.test", "added"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "in_cinemas", "1999-03-30T00:00:00Z"),
+					resource.TestCheckResourceAttrSet("radarr_movie.test", "digital_release"),
+					resource.TestCheckNoResourceAttr("radarr_movie.test", "last_search_time"),
 				),
 			},
 			// Unauthorized Read
@@ -49,6 +59,7 @@ func TestAccMovieResource(t *testing.T) {
 				Config: testAccMovieResourceConfig("The Matrix", "test123", 603),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("radarr_movie.test", "path", "/config/test123"),
+					resource.TestCheckResourceAttr("radarr_movie.test", "move_files_on_path_change", "true"),
 				),
 			},
 			// ImportState testing
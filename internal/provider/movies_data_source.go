@@ -2,12 +2,17 @@ package provider
 
 import (
 	"context"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/radarr-go/radarr"
 	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -29,8 +34,19 @@ type MoviesDataSource struct {
 
 // Movies describes the movies data model.
 type Movies struct {
-	Movies types.Set    `tfsdk:"movies"`
-	ID     types.String `tfsdk:"id"`
+	Movies              types.Set    `tfsdk:"movies"`
+	Tags                types.Set    `tfsdk:"tags"`
+	Genres              types.Set    `tfsdk:"genres"`
+	ID                  types.String `tfsdk:"id"`
+	TagsMatch           types.String `tfsdk:"tags_match"`
+	Status              types.String `tfsdk:"status"`
+	MinimumAvailability types.String `tfsdk:"minimum_availability"`
+	RootFolderPath      types.String `tfsdk:"root_folder_path"`
+	QualityProfileID    types.Int64  `tfsdk:"quality_profile_id"`
+	YearMin             types.Int64  `tfsdk:"year_min"`
+	YearMax             types.Int64  `tfsdk:"year_max"`
+	Monitored           types.Bool   `tfsdk:"monitored"`
+	HasFile             types.Bool   `tfsdk:"has_file"`
 }
 
 func (d *MoviesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -40,12 +56,67 @@ func (d *MoviesDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 func (d *MoviesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "<!-- subcategory:Movies -->\nList all available [Movies](../resources/movie).",
+		MarkdownDescription: "<!-- subcategory:Movies -->\nList all available [Movies](../resources/movie).\nOptional filters are combined, only movies matching all of them are returned.",
 		Attributes: map[string]schema.Attribute{
 			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Filter by monitored flag.",
+				Optional:            true,
+			},
+			"has_file": schema.BoolAttribute{
+				MarkdownDescription: "Filter by has file flag.",
+				Optional:            true,
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Filter by quality profile ID.",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Filter by tags, see `tags_match`.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"tags_match": schema.StringAttribute{
+				MarkdownDescription: "Tags matching mode. `any` returns movies with at least one of the tags, `all` returns movies with every tag. Defaults to `any`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("any", "all"),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Filter by movie status.\nAllowed values: 'tba', 'announced', 'inCinemas', 'released', 'deleted'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("tba", "announced", "inCinemas", "released", "deleted"),
+				},
+			},
+			"minimum_availability": schema.StringAttribute{
+				MarkdownDescription: "Filter by minimum availability.\nAllowed values: 'tba', 'announced', 'inCinemas', 'released', 'deleted'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("tba", "announced", "inCinemas", "released", "deleted"),
+				},
+			},
+			"year_min": schema.Int64Attribute{
+				MarkdownDescription: "Filter by minimum year, included.",
+				Optional:            true,
+			},
+			"year_max": schema.Int64Attribute{
+				MarkdownDescription: "Filter by maximum year, included.",
+				Optional:            true,
+			},
+			"genres": schema.SetAttribute{
+				MarkdownDescription: "Filter by genres. Movies with at least one of the genres are returned.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Filter by root folder. Movies inside the given root folder are returned, a trailing separator is ignored.",
+				Optional:            true,
+			},
 			"movies": schema.SetNestedAttribute{
 				MarkdownDescription: "Movie list.",
 				Computed:            true,
@@ -135,6 +206,98 @@ func (d *MoviesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 								},
 							},
 						},
+						"has_file": schema.BoolAttribute{
+							MarkdownDescription: "Has file flag.",
+							Computed:            true,
+						},
+						"size_on_disk": schema.Int64Attribute{
+							MarkdownDescription: "Size on disk in bytes.",
+							Computed:            true,
+						},
+						"runtime": schema.Int64Attribute{
+							MarkdownDescription: "Runtime in minutes.",
+							Computed:            true,
+						},
+						"popularity": schema.Float64Attribute{
+							MarkdownDescription: "Popularity.",
+							Computed:            true,
+						},
+						"studio": schema.StringAttribute{
+							MarkdownDescription: "Studio.",
+							Computed:            true,
+						},
+						"certification": schema.StringAttribute{
+							MarkdownDescription: "Certification.",
+							Computed:            true,
+						},
+						"added": schema.StringAttribute{
+							MarkdownDescription: "Date the movie was added to Radarr, in RFC3339 format.",
+							Computed:            true,
+						},
+						"in_cinemas": schema.StringAttribute{
+							MarkdownDescription: "In cinemas release date, in RFC3339 format.",
+							Computed:            true,
+						},
+						"digital_release": schema.StringAttribute{
+							MarkdownDescription: "Digital release date, in RFC3339 format.",
+							Computed:            true,
+						},
+						"physical_release": schema.StringAttribute{
+							MarkdownDescription: "Physical release date, in RFC3339 format.",
+							Computed:            true,
+						},
+						"last_search_time": schema.StringAttribute{
+							MarkdownDescription: "Last automatic search time, in RFC3339 format.",
+							Computed:            true,
+						},
+						"images": schema.SetNestedAttribute{
+							MarkdownDescription: "Images.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: MovieDataSource{}.getImageSchema().Attributes,
+							},
+						},
+						"ratings": schema.SingleNestedAttribute{
+							MarkdownDescription: "Ratings.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"imdb": schema.SingleNestedAttribute{
+									MarkdownDescription: "IMDB rating.",
+									Computed:            true,
+									Attributes:          MovieDataSource{}.getRatingSchema().Attributes,
+								},
+								"tmdb": schema.SingleNestedAttribute{
+									MarkdownDescription: "TMDB rating.",
+									Computed:            true,
+									Attributes:          MovieDataSource{}.getRatingSchema().Attributes,
+								},
+								"metacritic": schema.SingleNestedAttribute{
+									MarkdownDescription: "Metacritic rating.",
+									Computed:            true,
+									Attributes:          MovieDataSource{}.getRatingSchema().Attributes,
+								},
+								"rotten_tomatoes": schema.SingleNestedAttribute{
+									MarkdownDescription: "Rotten Tomatoes rating.",
+									Computed:            true,
+									Attributes:          MovieDataSource{}.getRatingSchema().Attributes,
+								},
+								"trakt": schema.SingleNestedAttribute{
+									MarkdownDescription: "Trakt rating.",
+									Computed:            true,
+									Attributes:          MovieDataSource{}.getRatingSchema().Attributes,
+								},
+							},
+						},
+						"movie_file": schema.SingleNestedAttribute{
+							MarkdownDescription: "Movie file. Null if the movie has no file.",
+							Computed:            true,
+							Attributes:          MovieDataSource{}.getMovieFileSchema().Attributes,
+						},
+						"collection": schema.SingleNestedAttribute{
+							MarkdownDescription: "Collection the movie belongs to. Null if the movie is not part of a collection.",
+							Computed:            true,
+							Attributes:          MovieDataSource{}.getCollectionSchema().Attributes,
+						},
 					},
 				},
 			},
@@ -149,7 +312,15 @@ func (d *MoviesDataSource) Configure(ctx context.Context, req datasource.Configu
 	}
 }
 
-func (d *MoviesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *MoviesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Movies
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get movies current value
 	response, _, err := d.client.MovieAPI.ListMovie(d.auth).Execute()
 	if err != nil {
@@ -159,13 +330,111 @@ func (d *MoviesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, r
 	}
 
 	tflog.Trace(ctx, "read "+moviesDataSourceName)
-	// Map response body to resource schema attribute
-	movies := make([]Movie, len(response))
-	for i, m := range response {
-		movies[i].write(ctx, &m, &resp.Diagnostics)
+	// Map filtered response body to resource schema attribute
+	filter := data.filter(ctx, &resp.Diagnostics)
+	movies := make([]Movie, 0, len(response))
+
+	for _, m := range response {
+		if filter.match(&m) {
+			movie := Movie{}
+			movie.write(ctx, &m, &resp.Diagnostics)
+			movies = append(movies, movie)
+		}
 	}
 
-	movieList, diags := types.SetValueFrom(ctx, Movie{}.getType(), movies)
+	var diags diag.Diagnostics
+
+	data.Movies, diags = types.SetValueFrom(ctx, Movie{}.getType(), movies)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, Movies{Movies: movieList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+
+	data.ID = types.StringValue(strconv.Itoa(len(movies)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// movieFilter holds the filters to be applied to the movie list.
+type movieFilter struct {
+	status              *string
+	minimumAvailability *string
+	rootFolderPath      *string
+	qualityProfileID    *int64
+	yearMin             *int64
+	yearMax             *int64
+	monitored           *bool
+	hasFile             *bool
+	tags                []int32
+	genres              []string
+	allTags             bool
+}
+
+func (m *Movies) filter(ctx context.Context, diags *diag.Diagnostics) movieFilter {
+	filter := movieFilter{
+		status:              m.Status.ValueStringPointer(),
+		minimumAvailability: m.MinimumAvailability.ValueStringPointer(),
+		rootFolderPath:      m.RootFolderPath.ValueStringPointer(),
+		qualityProfileID:    m.QualityProfileID.ValueInt64Pointer(),
+		yearMin:             m.YearMin.ValueInt64Pointer(),
+		yearMax:             m.YearMax.ValueInt64Pointer(),
+		monitored:           m.Monitored.ValueBoolPointer(),
+		hasFile:             m.HasFile.ValueBoolPointer(),
+		allTags:             m.TagsMatch.ValueString() == "all",
+	}
+
+	diags.Append(m.Tags.ElementsAs(ctx, &filter.tags, true)...)
+	diags.Append(m.Genres.ElementsAs(ctx, &filter.genres, true)...)
+
+	return filter
+}
+
+func (f movieFilter) match(movie *radarr.MovieResource) bool {
+	return f.matchFlags(movie) && f.matchValues(movie) && f.matchTags(movie) && f.matchGenres(movie)
+}
+
+func (f movieFilter) matchFlags(movie *radarr.MovieResource) bool {
+	return (f.monitored == nil || *f.monitored == movie.GetMonitored()) &&
+		(f.hasFile == nil || *f.hasFile == movie.GetHasFile())
+}
+
+func (f movieFilter) matchValues(movie *radarr.MovieResource) bool {
+	return (f.status == nil || *f.status == string(movie.GetStatus())) &&
+		(f.minimumAvailability == nil || *f.minimumAvailability == string(movie.GetMinimumAvailability())) &&
+		f.matchRootFolder(movie) &&
+		(f.qualityProfileID == nil || *f.qualityProfileID == int64(movie.GetQualityProfileId())) &&
+		(f.yearMin == nil || *f.yearMin <= int64(movie.GetYear())) &&
+		(f.yearMax == nil || *f.yearMax >= int64(movie.GetYear()))
+}
+
+// matchRootFolder compares the whole root folder, so that sibling folders sharing a prefix do not match.
+func (f movieFilter) matchRootFolder(movie *radarr.MovieResource) bool {
+	return f.rootFolderPath == nil ||
+		strings.TrimRight(*f.rootFolderPath, `/\`) == strings.TrimRight(movie.GetRootFolderPath(), `/\`)
+}
+
+func (f movieFilter) matchTags(movie *radarr.MovieResource) bool {
+	if len(f.tags) == 0 {
+		return true
+	}
+
+	// any mode stops at the first tag found, all mode at the first tag missing
+	for _, t := range f.tags {
+		found := slices.Contains(movie.GetTags(), t)
+		if found != f.allTags {
+			return found
+		}
+	}
+
+	return f.allTags
+}
+
+func (f movieFilter) matchGenres(movie *radarr.MovieResource) bool {
+	if len(f.genres) == 0 {
+		return true
+	}
+
+	for _, g := range f.genres {
+		if slices.Contains(movie.GetGenres(), g) {
+			return true
+		}
+	}
+
+	return false
 }
@@ -4,7 +4,9 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/radarr-go/radarr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccMoviesDataSource(t *testing.T) {
@@ -23,6 +25,14 @@ func TestAccMoviesDataSource(t *testing.T) {
 			{
 				Config: testAccMovieResourceConfig("Gladiator", "Gladiator_2000", 98) + testAccMoviesDataSourceConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.radarr_movies.test", "movies.*", map[string]string{"title": "Gladiator", "has_file": "false"}),
+				),
+			},
+			// Filter testing
+			{
+				Config: testAccMovieResourceConfig("Gladiator", "Gladiator_2000", 98) + testAccMoviesDataSourceFilterConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.radarr_movies.test", "movies.#", "1"),
 					resource.TestCheckTypeSetElemNestedAttrs("data.radarr_movies.test", "movies.*", map[string]string{"title": "Gladiator"}),
 				),
 			},
@@ -35,3 +45,64 @@ data "radarr_movies" "test" {
 	depends_on = [radarr_movie.test]
 }
 `
+
+const testAccMoviesDataSourceFilterConfig = `
+data "radarr_movies" "test" {
+	monitored = false
+	has_file = false
+	year_min = 2000
+	year_max = 2000
+	genres = ["Action", "Drama"]
+	root_folder_path = "/config/"
+	depends_on = [radarr_movie.test]
+}
+`
+
+func TestMovieFilterRootFolder(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		root     string
+		filter   string
+		expected bool
+	}{
+		"same root": {
+			root:     "/movies",
+			filter:   "/movies",
+			expected: true,
+		},
+		"trailing separator": {
+			root:     "/movies/",
+			filter:   "/movies",
+			expected: true,
+		},
+		"windows trailing separator": {
+			root:     `D:\Movies`,
+			filter:   `D:\Movies\`,
+			expected: true,
+		},
+		"sibling sharing prefix": {
+			root:     "/movies-4k",
+			filter:   "/movies",
+			expected: false,
+		},
+		"parent folder": {
+			root:     "/data/movies",
+			filter:   "/data",
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			movie := radarr.NewMovieResource()
+			movie.SetRootFolderPath(test.root)
+			movie.SetPath(test.root + "/Gladiator (2000)")
+
+			assert.Equal(t, test.expected, movieFilter{rootFolderPath: &test.filter}.match(movie))
+		})
+	}
+}
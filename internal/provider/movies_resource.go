@@ -0,0 +1,471 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const moviesResourceName = "movies"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MoviesResource{}
+
+func NewMoviesResource() resource.Resource {
+	return &MoviesResource{}
+}
+
+// MoviesResource defines the bulk movies implementation.
+type MoviesResource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// MoviesBulk describes the bulk movies data model.
+type MoviesBulk struct {
+	TMDBIDs               types.Set    `tfsdk:"tmdb_ids"`
+	Tags                  types.Set    `tfsdk:"tags"`
+	MovieIDs              types.Map    `tfsdk:"movie_ids"`
+	RootFolderPath        types.String `tfsdk:"root_folder_path"`
+	MinimumAvailability   types.String `tfsdk:"minimum_availability"`
+	QualityProfileID      types.Int64  `tfsdk:"quality_profile_id"`
+	Monitored             types.Bool   `tfsdk:"monitored"`
+	MoveFilesOnPathChange types.Bool   `tfsdk:"move_files_on_path_change"`
+}
+
+func (r *MoviesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + moviesResourceName
+}
+
+func (r *MoviesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Movies -->\nMovies resource, to manage a group of movies sharing the same settings.\nMovies are added through the bulk import and updated through the movie editor, which is faster than a [Movie](../resources/movie) per title for big libraries.\nMovies already in the library are adopted: they get the shared settings and are deleted from Radarr, without files, when removed from `tmdb_ids` or on destroy.\nFor more information refer to [Movies](https://wiki.servarr.com/radarr/library#movies) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"tmdb_ids": schema.SetAttribute{
+				MarkdownDescription: "TMDB IDs of the managed movies, including the ones already in the library.",
+				Required:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile ID.",
+				Required:            true,
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Root folder path.",
+				Required:            true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag.",
+				Required:            true,
+			},
+			"minimum_availability": schema.StringAttribute{
+				MarkdownDescription: "Minimum availability.\nAllowed values: 'tba', 'announced', 'inCinemas', 'released', 'deleted'.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("released"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("tba", "announced", "inCinemas", "released", "deleted"),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.Int64Type, nil)),
+			},
+			"move_files_on_path_change": schema.BoolAttribute{
+				MarkdownDescription: "Move the movie files on disk when `root_folder_path` changes or a movie is adopted from another root folder. If false, only the paths stored in Radarr are updated.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"movie_ids": schema.MapAttribute{
+				MarkdownDescription: "Movie IDs by TMDB ID.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+	}
+}
+
+func (r *MoviesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *MoviesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var movies *MoviesBulk
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &movies)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Import new Movies
+	request := movies.read(ctx, movies.tmdbIDs(ctx, &resp.Diagnostics), &resp.Diagnostics)
+
+	imported := r.importMovies(request, helpers.Create, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	movies.trackMovieIDs(ctx, map[int32]int32{}, imported, &resp.Diagnostics)
+
+	// Apply shared settings also to already existing Movies
+	r.edit(ctx, movies, movies.MoveFilesOnPathChange.ValueBool(), &resp.Diagnostics)
+
+	tflog.Trace(ctx, "created "+moviesResourceName+": "+strconv.Itoa(len(request)))
+	// Generate resource state struct
+	resp.Diagnostics.Append(resp.State.Set(ctx, &movies)...)
+}
+
+func (r *MoviesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var movies *MoviesBulk
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &movies)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get movies current value
+	response, _, err := r.client.MovieAPI.ListMovie(r.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, moviesResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+moviesResourceName)
+	// Map response body to resource schema attribute
+	movies.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &movies)...)
+}
+
+func (r *MoviesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan and state values
+	var movies, state *MoviesBulk
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &movies)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := movies.tmdbIDs(ctx, &resp.Diagnostics)
+	current := state.movieIDs(ctx, &resp.Diagnostics)
+
+	// Delete Movies no longer managed
+	removed := make([]int32, 0, len(current))
+
+	for tmdbID, ID := range current {
+		if !slices.Contains(planned, tmdbID) {
+			removed = append(removed, ID)
+		}
+	}
+
+	if len(removed) > 0 {
+		if _, err := r.client.MovieEditorAPI.DeleteMovieEditor(r.auth).MovieEditorResource(*moviesDeleteRequest(removed)).Execute(); err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, moviesResourceName, err))
+
+			return
+		}
+	}
+
+	// Import new Movies
+	added := make([]int32, 0, len(planned))
+
+	for _, tmdbID := range planned {
+		if _, ok := current[tmdbID]; !ok {
+			added = append(added, tmdbID)
+		}
+	}
+
+	var imported []radarr.MovieResource
+
+	if len(added) > 0 {
+		if imported = r.importMovies(movies.read(ctx, added, &resp.Diagnostics), helpers.Update, &resp.Diagnostics); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for tmdbID := range current {
+		if !slices.Contains(planned, tmdbID) {
+			delete(current, tmdbID)
+		}
+	}
+
+	movies.trackMovieIDs(ctx, current, imported, &resp.Diagnostics)
+
+	// Apply shared settings to all Movies
+	r.edit(ctx, movies, movies.MoveFilesOnPathChange.ValueBool(), &resp.Diagnostics)
+
+	tflog.Trace(ctx, "updated "+moviesResourceName+": "+strconv.Itoa(len(planned)))
+	// Generate resource state struct
+	resp.Diagnostics.Append(resp.State.Set(ctx, &movies)...)
+}
+
+func (r *MoviesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var movies *MoviesBulk
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &movies)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	IDs := make([]int32, 0)
+	for _, ID := range movies.movieIDs(ctx, &resp.Diagnostics) {
+		IDs = append(IDs, ID)
+	}
+
+	// Delete movies current value
+	_, err := r.client.MovieEditorAPI.DeleteMovieEditor(r.auth).MovieEditorResource(*moviesDeleteRequest(IDs)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, moviesResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+moviesResourceName+": "+strconv.Itoa(len(IDs)))
+	resp.State.RemoveResource(ctx)
+}
+
+// importMovies adds the movies not yet in the library and returns them.
+func (r *MoviesResource) importMovies(request []radarr.MovieResource, action string, diags *diag.Diagnostics) []radarr.MovieResource {
+	// The client does not decode the body
+	httpResp, err := r.client.MovieImportAPI.CreateMovieImport(r.auth).MovieResource(request).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, moviesResourceName, err))
+
+		return nil
+	}
+
+	var response []radarr.MovieResource
+	if err := json.NewDecoder(httpResp.Body).Decode(&response); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, moviesResourceName, err))
+
+		return nil
+	}
+
+	return response
+}
+
+// edit applies the shared settings to the managed movies through the movie editor and refreshes them.
+func (r *MoviesResource) edit(ctx context.Context, movies *MoviesBulk, moveFiles bool, diags *diag.Diagnostics) {
+	response, _, err := r.client.MovieAPI.ListMovie(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, moviesResourceName, err))
+
+		return
+	}
+
+	planned := movies.tmdbIDs(ctx, diags)
+	request := movies.editorRequest(ctx, diags)
+	request.SetMoveFiles(moveFiles)
+
+	for _, movie := range response {
+		if slices.Contains(planned, movie.GetTmdbId()) {
+			request.MovieIds = append(request.MovieIds, movie.GetId())
+		}
+	}
+
+	if _, err = r.client.MovieEditorAPI.PutMovieEditor(r.auth).MovieEditorResource(*request).Execute(); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, moviesResourceName, err))
+
+		return
+	}
+
+	response, _, err = r.client.MovieAPI.ListMovie(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, moviesResourceName, err))
+
+		return
+	}
+
+	movies.write(ctx, response, diags)
+
+	// Movies not available on TMDB are silently skipped by the import
+	current := movies.movieIDs(ctx, diags)
+	for _, tmdbID := range planned {
+		if _, ok := current[tmdbID]; !ok {
+			diags.AddError(helpers.ResourceError, helpers.ParseNotFoundError(moviesResourceName, "TMDB ID", strconv.Itoa(int(tmdbID))))
+		}
+	}
+}
+
+// write maps the managed movies into the data model.
+// If a movie differs from the shared settings, its value is reported to surface the drift.
+func (m *MoviesBulk) write(ctx context.Context, movies []radarr.MovieResource, diags *diag.Diagnostics) {
+	var (
+		tempDiag diag.Diagnostics
+		tags     []int32
+	)
+
+	managed := m.tmdbIDs(ctx, diags)
+	diags.Append(m.Tags.ElementsAs(ctx, &tags, true)...)
+	slices.Sort(tags)
+
+	tmdbIDs := make([]int32, 0, len(managed))
+	movieIDs := make(map[string]int64, len(managed))
+
+	for _, movie := range movies {
+		if !slices.Contains(managed, movie.GetTmdbId()) {
+			continue
+		}
+
+		tmdbIDs = append(tmdbIDs, movie.GetTmdbId())
+		movieIDs[strconv.Itoa(int(movie.GetTmdbId()))] = int64(movie.GetId())
+
+		if movie.GetQualityProfileId() != int32(m.QualityProfileID.ValueInt64()) {
+			m.QualityProfileID = types.Int64Value(int64(movie.GetQualityProfileId()))
+		}
+
+		if movie.GetRootFolderPath() != m.RootFolderPath.ValueString() {
+			m.RootFolderPath = types.StringValue(movie.GetRootFolderPath())
+		}
+
+		if movie.GetMonitored() != m.Monitored.ValueBool() {
+			m.Monitored = types.BoolValue(movie.GetMonitored())
+		}
+
+		if string(movie.GetMinimumAvailability()) != m.MinimumAvailability.ValueString() {
+			m.MinimumAvailability = types.StringValue(string(movie.GetMinimumAvailability()))
+		}
+
+		movieTags := slices.Clone(movie.GetTags())
+		slices.Sort(movieTags)
+
+		if !slices.Equal(movieTags, tags) {
+			tags = movieTags
+		}
+	}
+
+	m.TMDBIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tmdbIDs)
+	diags.Append(tempDiag...)
+	m.Tags, tempDiag = types.SetValueFrom(ctx, types.Int64Type, tags)
+	diags.Append(tempDiag...)
+	m.MovieIDs, tempDiag = types.MapValueFrom(ctx, types.Int64Type, movieIDs)
+	diags.Append(tempDiag...)
+}
+
+// read builds the import request for the given TMDB IDs.
+func (m *MoviesBulk) read(ctx context.Context, tmdbIDs []int32, diags *diag.Diagnostics) []radarr.MovieResource {
+	var tags []int32
+
+	diags.Append(m.Tags.ElementsAs(ctx, &tags, true)...)
+
+	movies := make([]radarr.MovieResource, len(tmdbIDs))
+	for i, tmdbID := range tmdbIDs {
+		movies[i] = *radarr.NewMovieResource()
+		movies[i].SetTmdbId(tmdbID)
+		movies[i].SetQualityProfileId(int32(m.QualityProfileID.ValueInt64()))
+		movies[i].SetRootFolderPath(m.RootFolderPath.ValueString())
+		movies[i].SetMonitored(m.Monitored.ValueBool())
+		movies[i].SetMinimumAvailability(radarr.MovieStatusType(m.MinimumAvailability.ValueString()))
+		movies[i].SetTags(tags)
+	}
+
+	return movies
+}
+
+// editorRequest builds the movie editor request with the shared settings.
+func (m *MoviesBulk) editorRequest(ctx context.Context, diags *diag.Diagnostics) *radarr.MovieEditorResource {
+	var tags []int32
+
+	diags.Append(m.Tags.ElementsAs(ctx, &tags, true)...)
+
+	editor := radarr.NewMovieEditorResource()
+	editor.SetMovieIds([]int32{})
+	editor.SetQualityProfileId(int32(m.QualityProfileID.ValueInt64()))
+	editor.SetRootFolderPath(m.RootFolderPath.ValueString())
+	editor.SetMonitored(m.Monitored.ValueBool())
+	editor.SetMinimumAvailability(radarr.MovieStatusType(m.MinimumAvailability.ValueString()))
+	editor.SetTags(tags)
+	editor.SetApplyTags(radarr.APPLYTAGS_REPLACE)
+
+	return editor
+}
+
+func (m *MoviesBulk) tmdbIDs(ctx context.Context, diags *diag.Diagnostics) []int32 {
+	var tmdbIDs []int32
+
+	diags.Append(m.TMDBIDs.ElementsAs(ctx, &tmdbIDs, true)...)
+
+	return tmdbIDs
+}
+
+// trackMovieIDs sets the movie IDs known before the edit, adding the imported movies,
+// so that they are deleted with the resource even if the edit fails.
+func (m *MoviesBulk) trackMovieIDs(ctx context.Context, IDs map[int32]int32, imported []radarr.MovieResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	movieIDs := make(map[string]int64, len(IDs)+len(imported))
+
+	for tmdbID, ID := range IDs {
+		movieIDs[strconv.Itoa(int(tmdbID))] = int64(ID)
+	}
+
+	for _, movie := range imported {
+		movieIDs[strconv.Itoa(int(movie.GetTmdbId()))] = int64(movie.GetId())
+	}
+
+	m.MovieIDs, tempDiag = types.MapValueFrom(ctx, types.Int64Type, movieIDs)
+	diags.Append(tempDiag...)
+}
+
+// movieIDs returns the movie IDs by TMDB ID.
+func (m *MoviesBulk) movieIDs(ctx context.Context, diags *diag.Diagnostics) map[int32]int32 {
+	var movieIDs map[string]int32
+
+	diags.Append(m.MovieIDs.ElementsAs(ctx, &movieIDs, true)...)
+
+	IDs := make(map[int32]int32, len(movieIDs))
+
+	for tmdbID, ID := range movieIDs {
+		key, err := strconv.Atoi(tmdbID)
+		if err != nil {
+			diags.AddError(helpers.ResourceError, "Unexpected TMDB ID in movie_ids: "+tmdbID)
+
+			continue
+		}
+
+		IDs[int32(key)] = ID
+	}
+
+	return IDs
+}
+
+func moviesDeleteRequest(IDs []int32) *radarr.MovieEditorResource {
+	editor := radarr.NewMovieEditorResource()
+	editor.SetMovieIds(IDs)
+	editor.SetDeleteFiles(false)
+	editor.SetAddImportExclusion(false)
+
+	return editor
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMoviesResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccMoviesResourceConfig("550", false) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccMoviesResourceConfig("550, 13", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_movies.test", "tmdb_ids.#", "2"),
+					resource.TestCheckResourceAttr("radarr_movies.test", "monitored", "false"),
+					resource.TestCheckResourceAttr("radarr_movies.test", "minimum_availability", "released"),
+					resource.TestCheckResourceAttrSet("radarr_movies.test", "movie_ids.550"),
+					resource.TestCheckResourceAttrSet("radarr_movies.test", "movie_ids.13"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccMoviesResourceConfig("550", false) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccMoviesResourceConfig("550, 278", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_movies.test", "tmdb_ids.#", "2"),
+					resource.TestCheckResourceAttr("radarr_movies.test", "monitored", "true"),
+					resource.TestCheckResourceAttrSet("radarr_movies.test", "movie_ids.278"),
+					resource.TestCheckNoResourceAttr("radarr_movies.test", "movie_ids.13"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccMoviesResourceAdopt(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Adopt a movie from another root folder
+			{
+				PreConfig: moviesAdoptInit,
+				Config:    testAccMoviesResourceConfig("62", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("radarr_movies.test", "movie_ids.62"),
+					resource.TestCheckResourceAttr("radarr_movies.test", "root_folder_path", "/config"),
+					resource.TestCheckResourceAttr("radarr_movies.test", "move_files_on_path_change", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func moviesAdoptInit() {
+	rootFolderDSInit()
+	// ensure the movie is already in the library, outside of the managed root folder
+	client := testAccAPIClient()
+	movie := radarr.NewMovieResource()
+	movie.SetTmdbId(62)
+	movie.SetTitle("2001: A Space Odyssey")
+	movie.SetYear(1968)
+	movie.SetPath("/other/2001 A Space Odyssey (1968)")
+	movie.SetQualityProfileId(1)
+	movie.SetMinimumAvailability(radarr.MOVIESTATUSTYPE_RELEASED)
+	_, _, _ = client.MovieAPI.CreateMovie(context.TODO()).MovieResource(*movie).Execute()
+}
+
+func testAccMoviesResourceConfig(tmdbIDs string, monitored bool) string {
+	return fmt.Sprintf(`
+		resource "radarr_movies" "test" {
+			tmdb_ids = [%s]
+			monitored = %t
+			quality_profile_id = 1
+			root_folder_path = "/config"
+		}
+	`, tmdbIDs, monitored)
+}
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/devopsarr/radarr-go/radarr"
 	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
@@ -47,6 +48,23 @@ type ExtraHeader struct {
 type RadarrData struct {
 	Auth   context.Context
 	Client *radarr.APIClient
+	mu     sync.RWMutex
+}
+
+// getAuth returns the current auth, it can be switched by resources changing the Radarr address.
+func (d *RadarrData) getAuth() context.Context {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.Auth
+}
+
+// setAuth switches the auth used by the resources configured afterwards.
+func (d *RadarrData) setAuth(auth context.Context) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.Auth = auth
 }
 
 func (p *RadarrProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -160,16 +178,19 @@ func (p *RadarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		"hostpath": parsedAPIURL.Host,
 	})
 
-	radarrData := RadarrData{
+	radarrData := &RadarrData{
 		Auth:   auth,
 		Client: radarr.NewAPIClient(config),
 	}
-	resp.DataSourceData = &radarrData
-	resp.ResourceData = &radarrData
+	resp.DataSourceData = radarrData
+	resp.ResourceData = radarrData
 }
 
 func (p *RadarrProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		// Activity
+		NewBlocklistCleanupResource,
+
 		// Download Clients
 		NewDownloadClientConfigResource,
 		NewDownloadClientResource,
@@ -232,6 +253,7 @@ func (p *RadarrProvider) Resources(_ context.Context) []func() resource.Resource
 		NewMediaManagementResource,
 		NewNamingResource,
 		NewRootFolderResource,
+		NewRootFolderImportResource,
 
 		// Metadata
 		NewMetadataEmbyResource,
@@ -243,6 +265,10 @@ func (p *RadarrProvider) Resources(_ context.Context) []func() resource.Resource
 
 		// Movies
 		NewMovieResource,
+		NewMoviesResource,
+		NewMovieBulkEditResource,
+		NewMovieRenameResource,
+		NewCollectionResource,
 
 		// Notifications
 		NewNotificationResource,
@@ -278,6 +304,9 @@ func (p *RadarrProvider) Resources(_ context.Context) []func() resource.Resource
 
 		// System
 		NewHostResource,
+		NewCommandResource,
+		NewUpdateResource,
+		NewBackupResource,
 
 		// Tags
 		NewTagResource,
@@ -287,6 +316,11 @@ func (p *RadarrProvider) Resources(_ context.Context) []func() resource.Resource
 
 func (p *RadarrProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		// Activity
+		NewHistoryDataSource,
+		NewBlocklistDataSource,
+		NewQueueDataSource,
+
 		// Download Clients
 		NewDownloadClientConfigDataSource,
 		NewDownloadClientDataSource,
@@ -318,6 +352,16 @@ func (p *RadarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		// Movies
 		NewMovieDataSource,
 		NewMoviesDataSource,
+		NewCollectionDataSource,
+		NewCollectionsDataSource,
+		NewMovieFileDataSource,
+		NewMovieFilesDataSource,
+		NewMovieCreditsDataSource,
+		NewMovieLookupDataSource,
+		NewMovieRenamePreviewDataSource,
+		NewCalendarDataSource,
+		NewWantedMissingDataSource,
+		NewWantedCutoffDataSource,
 
 		// Notifications
 		NewImportListDataSource,
@@ -351,6 +395,11 @@ func (p *RadarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		// System
 		NewSystemStatusDataSource,
 		NewHostDataSource,
+		NewHealthDataSource,
+		NewDiskSpaceDataSource,
+		NewUpdatesDataSource,
+		NewBackupsDataSource,
+		NewSystemTasksDataSource,
 
 		// Tags
 		NewTagDataSource,
@@ -391,7 +440,7 @@ func resourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *r
 		return nil, nil
 	}
 
-	return providerData.Auth, providerData.Client
+	return providerData.getAuth(), providerData.Client
 }
 
 func dataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) (context.Context, *radarr.APIClient) {
@@ -410,5 +459,5 @@ func dataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, res
 		return nil, nil
 	}
 
-	return providerData.Auth, providerData.Client
+	return providerData.getAuth(), providerData.Client
 }
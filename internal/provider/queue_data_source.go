@@ -0,0 +1,345 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	queueDataSourceName = "queue"
+	queuePageSize       = 100
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &QueueDataSource{}
+
+func NewQueueDataSource() datasource.DataSource {
+	return &QueueDataSource{}
+}
+
+// QueueDataSource defines the queue implementation.
+type QueueDataSource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// Queue describes the queue data model.
+type Queue struct {
+	Items                 types.Set    `tfsdk:"items"`
+	ID                    types.String `tfsdk:"id"`
+	Protocol              types.String `tfsdk:"protocol"`
+	Status                types.String `tfsdk:"status"`
+	TrackedDownloadStatus types.String `tfsdk:"tracked_download_status"`
+	TrackedDownloadState  types.String `tfsdk:"tracked_download_state"`
+	DownloadClientID      types.Int64  `tfsdk:"download_client_id"`
+}
+
+// QueueItem is part of Queue.
+type QueueItem struct {
+	StatusMessages          types.Set    `tfsdk:"status_messages"`
+	Title                   types.String `tfsdk:"title"`
+	DownloadClient          types.String `tfsdk:"download_client"`
+	DownloadID              types.String `tfsdk:"download_id"`
+	Protocol                types.String `tfsdk:"protocol"`
+	Indexer                 types.String `tfsdk:"indexer"`
+	Status                  types.String `tfsdk:"status"`
+	TrackedDownloadStatus   types.String `tfsdk:"tracked_download_status"`
+	TrackedDownloadState    types.String `tfsdk:"tracked_download_state"`
+	ErrorMessage            types.String `tfsdk:"error_message"`
+	TimeLeft                types.String `tfsdk:"time_left"`
+	EstimatedCompletionTime types.String `tfsdk:"estimated_completion_time"`
+	Added                   types.String `tfsdk:"added"`
+	ID                      types.Int64  `tfsdk:"id"`
+	MovieID                 types.Int64  `tfsdk:"movie_id"`
+	Size                    types.Int64  `tfsdk:"size"`
+	SizeLeft                types.Int64  `tfsdk:"size_left"`
+	CustomFormatScore       types.Int64  `tfsdk:"custom_format_score"`
+}
+
+func (q QueueItem) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"status_messages":           types.SetType{}.WithElementType(types.StringType),
+			"title":                     types.StringType,
+			"download_client":           types.StringType,
+			"download_id":               types.StringType,
+			"protocol":                  types.StringType,
+			"indexer":                   types.StringType,
+			"status":                    types.StringType,
+			"tracked_download_status":   types.StringType,
+			"tracked_download_state":    types.StringType,
+			"error_message":             types.StringType,
+			"time_left":                 types.StringType,
+			"estimated_completion_time": types.StringType,
+			"added":                     types.StringType,
+			"id":                        types.Int64Type,
+			"movie_id":                  types.Int64Type,
+			"size":                      types.Int64Type,
+			"size_left":                 types.Int64Type,
+			"custom_format_score":       types.Int64Type,
+		})
+}
+
+func (d *QueueDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + queueDataSourceName
+}
+
+func (d *QueueDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Activity -->\nList the download queue items.\nOptional filters are combined, only items matching all of them are returned.\nFor more information refer to [Queue](https://wiki.servarr.com/radarr/activity#queue) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"download_client_id": schema.Int64Attribute{
+				MarkdownDescription: "Filter by download client ID.",
+				Optional:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Filter by protocol.\nAllowed values: 'usenet', 'torrent'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(radarr.DOWNLOADPROTOCOL_USENET), string(radarr.DOWNLOADPROTOCOL_TORRENT)),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Filter by download status.\nAllowed values: 'unknown', 'queued', 'paused', 'downloading', 'completed', 'failed', 'warning', 'delay', 'downloadClientUnavailable', 'fallback'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("unknown", "queued", "paused", "downloading", "completed", "failed", "warning", "delay", "downloadClientUnavailable", "fallback"),
+				},
+			},
+			"tracked_download_status": schema.StringAttribute{
+				MarkdownDescription: "Filter by tracked download status.\nAllowed values: 'ok', 'warning', 'error'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(radarr.TRACKEDDOWNLOADSTATUS_OK), string(radarr.TRACKEDDOWNLOADSTATUS_WARNING), string(radarr.TRACKEDDOWNLOADSTATUS_ERROR)),
+				},
+			},
+			"tracked_download_state": schema.StringAttribute{
+				MarkdownDescription: "Filter by tracked download state.\nAllowed values: 'downloading', 'importBlocked', 'importPending', 'importing', 'imported', 'failedPending', 'failed', 'ignored'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(radarr.TRACKEDDOWNLOADSTATE_DOWNLOADING),
+						string(radarr.TRACKEDDOWNLOADSTATE_IMPORT_BLOCKED),
+						string(radarr.TRACKEDDOWNLOADSTATE_IMPORT_PENDING),
+						string(radarr.TRACKEDDOWNLOADSTATE_IMPORTING),
+						string(radarr.TRACKEDDOWNLOADSTATE_IMPORTED),
+						string(radarr.TRACKEDDOWNLOADSTATE_FAILED_PENDING),
+						string(radarr.TRACKEDDOWNLOADSTATE_FAILED),
+						string(radarr.TRACKEDDOWNLOADSTATE_IGNORED),
+					),
+				},
+			},
+			"items": schema.SetNestedAttribute{
+				MarkdownDescription: "Queue item list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Queue item ID.",
+							Computed:            true,
+						},
+						"movie_id": schema.Int64Attribute{
+							MarkdownDescription: "Movie ID. Null if the download is not matched to a movie.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Release title.",
+							Computed:            true,
+						},
+						"download_client": schema.StringAttribute{
+							MarkdownDescription: "Download client name.",
+							Computed:            true,
+						},
+						"download_id": schema.StringAttribute{
+							MarkdownDescription: "Download ID in the download client.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol.",
+							Computed:            true,
+						},
+						"indexer": schema.StringAttribute{
+							MarkdownDescription: "Indexer name.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Download status.",
+							Computed:            true,
+						},
+						"tracked_download_status": schema.StringAttribute{
+							MarkdownDescription: "Tracked download status. Either 'ok', 'warning' or 'error'.",
+							Computed:            true,
+						},
+						"tracked_download_state": schema.StringAttribute{
+							MarkdownDescription: "Tracked download state, e.g. 'downloading', 'importPending', 'failedPending'.",
+							Computed:            true,
+						},
+						"status_messages": schema.SetAttribute{
+							MarkdownDescription: "Status messages.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"error_message": schema.StringAttribute{
+							MarkdownDescription: "Error message.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size in bytes.",
+							Computed:            true,
+						},
+						"size_left": schema.Int64Attribute{
+							MarkdownDescription: "Size left in bytes.",
+							Computed:            true,
+						},
+						"time_left": schema.StringAttribute{
+							MarkdownDescription: "Time left, in 'HH:MM:SS' format.",
+							Computed:            true,
+						},
+						"estimated_completion_time": schema.StringAttribute{
+							MarkdownDescription: "Estimated completion time, in RFC3339 format.",
+							Computed:            true,
+						},
+						"added": schema.StringAttribute{
+							MarkdownDescription: "Date the item was added to the queue, in RFC3339 format.",
+							Computed:            true,
+						},
+						"custom_format_score": schema.Int64Attribute{
+							MarkdownDescription: "Custom format score.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *QueueDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *QueueDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Queue
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Queue items only report the download client name
+	var downloadClient *string
+
+	if !data.DownloadClientID.IsNull() {
+		client, _, err := d.client.DownloadClientAPI.GetDownloadClientById(d.auth, int32(data.DownloadClientID.ValueInt64())).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientResourceName, err))
+
+			return
+		}
+
+		downloadClient = client.Name.Get()
+	}
+
+	// Get queue current value
+	response, err := d.list(data.Protocol.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, queueDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+queueDataSourceName)
+	// Map filtered response body to resource schema attribute
+	items := make([]QueueItem, 0, len(response))
+
+	for _, q := range response {
+		if (downloadClient == nil || *downloadClient == q.GetDownloadClient()) &&
+			(data.Status.IsNull() || data.Status.ValueString() == q.GetStatus()) &&
+			(data.TrackedDownloadStatus.IsNull() || data.TrackedDownloadStatus.ValueString() == string(q.GetTrackedDownloadStatus())) &&
+			(data.TrackedDownloadState.IsNull() || data.TrackedDownloadState.ValueString() == string(q.GetTrackedDownloadState())) {
+			item := QueueItem{}
+			item.write(ctx, &q, &resp.Diagnostics)
+			items = append(items, item)
+		}
+	}
+
+	var diags diag.Diagnostics
+
+	data.Items, diags = types.SetValueFrom(ctx, QueueItem{}.getType(), items)
+	resp.Diagnostics.Append(diags...)
+
+	data.ID = types.StringValue(strconv.Itoa(len(items)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// list fetches all the queue pages.
+func (d *QueueDataSource) list(protocol string) ([]radarr.QueueResource, error) {
+	return helpers.ListPages[radarr.QueueResource](func(page int32) (*radarr.QueueResourcePagingResource, *http.Response, error) {
+		request := d.client.QueueAPI.GetQueue(d.auth).Page(page).PageSize(queuePageSize).IncludeUnknownMovieItems(true)
+		if protocol != "" {
+			request = request.Protocol(radarr.DownloadProtocol(protocol))
+		}
+
+		return request.Execute()
+	}, nil)
+}
+
+func (q *QueueItem) write(ctx context.Context, item *radarr.QueueResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	q.ID = types.Int64Value(int64(item.GetId()))
+	q.MovieID = types.Int64Null()
+
+	if item.MovieId.Get() != nil {
+		q.MovieID = types.Int64Value(int64(item.GetMovieId()))
+	}
+
+	q.Title = types.StringValue(item.GetTitle())
+	q.DownloadClient = types.StringValue(item.GetDownloadClient())
+	q.DownloadID = types.StringValue(item.GetDownloadId())
+	q.Protocol = types.StringValue(string(item.GetProtocol()))
+	q.Indexer = types.StringValue(item.GetIndexer())
+	q.Status = types.StringValue(item.GetStatus())
+	q.TrackedDownloadStatus = types.StringValue(string(item.GetTrackedDownloadStatus()))
+	q.TrackedDownloadState = types.StringValue(string(item.GetTrackedDownloadState()))
+	q.ErrorMessage = types.StringValue(item.GetErrorMessage())
+	q.TimeLeft = types.StringValue(item.GetTimeleft())
+	q.EstimatedCompletionTime = helpers.TimeValue(item.EstimatedCompletionTime.Get())
+	q.Added = helpers.TimeValue(item.Added.Get())
+	q.Size = types.Int64Value(int64(item.GetSize()))
+	q.SizeLeft = types.Int64Value(int64(item.GetSizeleft()))
+	q.CustomFormatScore = types.Int64Value(int64(item.GetCustomFormatScore()))
+
+	// Flatten status messages as "title: message"
+	messages := make([]string, 0, len(item.GetStatusMessages()))
+
+	for _, s := range item.GetStatusMessages() {
+		for _, m := range s.GetMessages() {
+			messages = append(messages, s.GetTitle()+": "+m)
+		}
+	}
+
+	q.StatusMessages, tempDiag = types.SetValueFrom(ctx, types.StringType, messages)
+	diags.Append(tempDiag...)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQueueDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccQueueDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccQueueDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.radarr_queue.test", "id"),
+					resource.TestCheckResourceAttr("data.radarr_queue.torrent", "protocol", "torrent"),
+					resource.TestCheckResourceAttr("data.radarr_queue.import_pending", "tracked_download_state", "importPending"),
+				),
+			},
+		},
+	})
+}
+
+const testAccQueueDataSourceConfig = `
+data "radarr_queue" "test" {
+}
+
+data "radarr_queue" "torrent" {
+	protocol = "torrent"
+	status   = "warning"
+}
+
+data "radarr_queue" "import_pending" {
+	tracked_download_status = "warning"
+	tracked_download_state  = "importPending"
+}
+`
@@ -43,6 +43,14 @@ func (d *RootFolderDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				MarkdownDescription: "Access flag.",
 				Computed:            true,
 			},
+			"free_space": schema.Int64Attribute{
+				MarkdownDescription: "Free space in bytes.",
+				Computed:            true,
+			},
+			"total_space": schema.Int64Attribute{
+				MarkdownDescription: "Total space in bytes of the mount containing the root folder.",
+				Computed:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Root Folder ID.",
 				Computed:            true,
@@ -90,17 +98,25 @@ func (d *RootFolderDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	folder.find(ctx, folder.Path.ValueString(), response, &resp.Diagnostics)
+	// Get disk space to compute total space
+	disks, _, err := d.client.DiskSpaceAPI.ListDiskSpace(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, rootFolderDataSourceName, err))
+
+		return
+	}
+
+	folder.find(ctx, folder.Path.ValueString(), response, disks, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "read "+rootFolderDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &folder)...)
 }
 
-func (r *RootFolder) find(ctx context.Context, path string, folders []radarr.RootFolderResource, diags *diag.Diagnostics) {
+func (r *RootFolder) find(ctx context.Context, path string, folders []radarr.RootFolderResource, disks []radarr.DiskSpaceResource, diags *diag.Diagnostics) {
 	for _, folder := range folders {
 		if folder.GetPath() == path {
-			r.write(ctx, &folder, diags)
+			r.write(ctx, &folder, disks, diags)
 
 			return
 		}
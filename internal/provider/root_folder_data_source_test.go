@@ -33,6 +33,7 @@ func TestAccRootFolderDataSource(t *testing.T) {
 				Config:    testAccRootFolderDataSourceConfig("/config"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.radarr_root_folder.test", "id"),
+					resource.TestCheckResourceAttrSet("data.radarr_root_folder.test", "total_space"),
 					resource.TestCheckResourceAttr("data.radarr_root_folder.test", "path", "/config")),
 			},
 		},
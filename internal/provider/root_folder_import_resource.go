@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const rootFolderImportResourceName = "root_folder_import"
+
+var (
+	// Folder name parts, e.g. "The Matrix (1999) {tmdb-603}" or "The Matrix (1999) [imdbid-tt0133093]".
+	folderTMDBIDRegexp = regexp.MustCompile(`(?i)\btmdb(?:id)?[-=](\d+)`)
+	folderIMDBIDRegexp = regexp.MustCompile(`(?i)\bimdb(?:id)?[-=](tt\d+)`)
+	folderYearRegexp   = regexp.MustCompile(`\((\d{4})\)`)
+	folderTitleRegexp  = regexp.MustCompile(`[^\p{L}\p{N}]+`)
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RootFolderImportResource{}
+
+func NewRootFolderImportResource() resource.Resource {
+	return &RootFolderImportResource{}
+}
+
+// RootFolderImportResource defines the root folder import implementation.
+type RootFolderImportResource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// RootFolderImport describes the root folder import data model.
+type RootFolderImport struct {
+	Tags                types.Set    `tfsdk:"tags"`
+	MatchedFolders      types.Set    `tfsdk:"matched_folders"`
+	UnmatchedFolders    types.Set    `tfsdk:"unmatched_folders"`
+	MinimumAvailability types.String `tfsdk:"minimum_availability"`
+	RootFolderID        types.Int64  `tfsdk:"root_folder_id"`
+	QualityProfileID    types.Int64  `tfsdk:"quality_profile_id"`
+	Monitored           types.Bool   `tfsdk:"monitored"`
+}
+
+// MatchedFolder is part of RootFolderImport.
+type MatchedFolder struct {
+	Name    types.String `tfsdk:"name"`
+	Path    types.String `tfsdk:"path"`
+	Title   types.String `tfsdk:"title"`
+	TMDBID  types.Int64  `tfsdk:"tmdb_id"`
+	Year    types.Int64  `tfsdk:"year"`
+	MovieID types.Int64  `tfsdk:"movie_id"`
+}
+
+func (m MatchedFolder) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name":     types.StringType,
+			"path":     types.StringType,
+			"title":    types.StringType,
+			"tmdb_id":  types.Int64Type,
+			"year":     types.Int64Type,
+			"movie_id": types.Int64Type,
+		})
+}
+
+func (r *RootFolderImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + rootFolderImportResourceName
+}
+
+func (r *RootFolderImportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Media Management -->\nRoot Folder Import resource.\nMatches the unmapped folders of a [Root Folder](root_folder) to movies through the lookup and adds them to the library in place.\nFolders are matched by the TMDB or IMDB ID in their name, e.g. `{tmdb-603}` or `[imdbid-tt0133093]`, otherwise by title and year, e.g. `The Matrix (1999)`.\nImport runs on create and on every change of the resource, settings only apply to the newly imported movies. Destroying it does not remove the movies.\nFor more information refer to [Library Import](https://wiki.servarr.com/radarr/library#import-existing-movies) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"root_folder_id": schema.Int64Attribute{
+				MarkdownDescription: "Root folder ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile ID.",
+				Required:            true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag.",
+				Required:            true,
+			},
+			"minimum_availability": schema.StringAttribute{
+				MarkdownDescription: "Minimum availability.\nAllowed values: 'tba', 'announced', 'inCinemas', 'released', 'deleted'.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("released"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("tba", "announced", "inCinemas", "released", "deleted"),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.Int64Type, nil)),
+			},
+			"matched_folders": schema.SetNestedAttribute{
+				MarkdownDescription: "Folders imported as movies.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Folder name.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Folder path.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Matched movie title.",
+							Computed:            true,
+						},
+						"tmdb_id": schema.Int64Attribute{
+							MarkdownDescription: "Matched movie TMDB ID.",
+							Computed:            true,
+						},
+						"year": schema.Int64Attribute{
+							MarkdownDescription: "Matched movie year.",
+							Computed:            true,
+						},
+						"movie_id": schema.Int64Attribute{
+							MarkdownDescription: "Imported movie ID.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"unmatched_folders": schema.SetNestedAttribute{
+				MarkdownDescription: "Folders without a movie match, or matching a movie already in library.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Folder name.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Folder path.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *RootFolderImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *RootFolderImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var folderImport *RootFolderImport
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &folderImport)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.importFolders(ctx, folderImport, nil, helpers.Create, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+rootFolderImportResourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &folderImport)...)
+}
+
+func (r *RootFolderImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Imported folders are not unmapped anymore, keep the import result
+	var folderImport *RootFolderImport
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &folderImport)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+rootFolderImportResourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &folderImport)...)
+}
+
+func (r *RootFolderImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var folderImport, state *RootFolderImport
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &folderImport)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the folders imported by previous runs
+	previous := make([]MatchedFolder, 0)
+	resp.Diagnostics.Append(state.MatchedFolders.ElementsAs(ctx, &previous, false)...)
+
+	r.importFolders(ctx, folderImport, previous, helpers.Update, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+rootFolderImportResourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &folderImport)...)
+}
+
+func (r *RootFolderImportResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Imported movies are not removed just removing configuration
+	tflog.Trace(ctx, "decoupled "+rootFolderImportResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// importFolders matches the unmapped folders and imports the matched ones.
+func (r *RootFolderImportResource) importFolders(ctx context.Context, folderImport *RootFolderImport, matched []MatchedFolder, action string, diags *diag.Diagnostics) {
+	folder, _, err := r.client.RootFolderAPI.GetRootFolderById(r.auth, int32(folderImport.RootFolderID.ValueInt64())).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, rootFolderImportResourceName, err))
+
+		return
+	}
+
+	movies := make([]radarr.MovieResource, 0, len(folder.GetUnmappedFolders()))
+	unmatched := make([]Path, 0)
+	tmdbIDs := make([]int32, 0, len(folder.GetUnmappedFolders()))
+
+	for _, f := range folder.GetUnmappedFolders() {
+		lookup, _, err := r.client.MovieLookupAPI.ListMovieLookup(r.auth).Term(folderLookupTerm(f.GetName())).Execute()
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(action, rootFolderImportResourceName, err))
+
+			return
+		}
+
+		// skip movies already in library or matched by another folder
+		movie := folderMatch(f.GetName(), lookup)
+		if movie == nil || movie.GetId() != 0 || slices.Contains(tmdbIDs, movie.GetTmdbId()) {
+			unmatched = append(unmatched, Path{Name: types.StringValue(f.GetName()), Path: types.StringValue(f.GetPath())})
+
+			continue
+		}
+
+		tmdbIDs = append(tmdbIDs, movie.GetTmdbId())
+		movies = append(movies, *folderImport.read(ctx, movie, f.GetPath(), diags))
+		matched = append(matched, MatchedFolder{
+			Name:   types.StringValue(f.GetName()),
+			Path:   types.StringValue(f.GetPath()),
+			Title:  types.StringValue(movie.GetTitle()),
+			TMDBID: types.Int64Value(int64(movie.GetTmdbId())),
+			Year:   types.Int64Value(int64(movie.GetYear())),
+		})
+	}
+
+	if len(movies) > 0 {
+		if _, err := r.client.MovieImportAPI.CreateMovieImport(r.auth).MovieResource(movies).Execute(); err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(action, rootFolderImportResourceName, err))
+
+			return
+		}
+	}
+
+	// Map the imported movies IDs
+	library, _, err := r.client.MovieAPI.ListMovie(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, rootFolderImportResourceName, err))
+
+		return
+	}
+
+	for i := range matched {
+		matched[i].MovieID = types.Int64Null()
+
+		for _, m := range library {
+			if int64(m.GetTmdbId()) == matched[i].TMDBID.ValueInt64() {
+				matched[i].MovieID = types.Int64Value(int64(m.GetId()))
+
+				break
+			}
+		}
+	}
+
+	var tempDiag diag.Diagnostics
+
+	folderImport.MatchedFolders, tempDiag = types.SetValueFrom(ctx, MatchedFolder{}.getType(), matched)
+	diags.Append(tempDiag...)
+	folderImport.UnmatchedFolders, tempDiag = types.SetValueFrom(ctx, Path{}.getType(), unmatched)
+	diags.Append(tempDiag...)
+}
+
+// folderLookupTerm returns the lookup term for the folder, preferring the IDs in its name.
+func folderLookupTerm(name string) string {
+	if id := folderTMDBIDRegexp.FindStringSubmatch(name); id != nil {
+		return "tmdb:" + id[1]
+	}
+
+	if id := folderIMDBIDRegexp.FindStringSubmatch(name); id != nil {
+		return "imdb:" + id[1]
+	}
+
+	return name
+}
+
+// folderMatch returns the lookup result matching the IDs in the folder name, or its title and year.
+func folderMatch(name string, lookup []radarr.MovieResource) *radarr.MovieResource {
+	tmdbID := folderTMDBIDRegexp.FindStringSubmatch(name)
+	imdbID := folderIMDBIDRegexp.FindStringSubmatch(name)
+	year := folderYearRegexp.FindStringSubmatch(name)
+	title := folderTitle(name)
+
+	for i, m := range lookup {
+		switch {
+		case tmdbID != nil:
+			if strconv.Itoa(int(m.GetTmdbId())) == tmdbID[1] {
+				return &lookup[i]
+			}
+		case imdbID != nil:
+			if strings.EqualFold(m.GetImdbId(), imdbID[1]) {
+				return &lookup[i]
+			}
+		case year != nil && strconv.Itoa(int(m.GetYear())) != year[1]:
+			continue
+		case folderTitle(m.GetTitle()) == title || folderTitle(m.GetOriginalTitle()) == title:
+			return &lookup[i]
+		}
+	}
+
+	return nil
+}
+
+// folderTitle normalizes the title, dropping the year and IDs parts of folder names.
+func folderTitle(name string) string {
+	if i := strings.IndexAny(name, "([{"); i > 0 {
+		name = name[:i]
+	}
+
+	return strings.ToLower(folderTitleRegexp.ReplaceAllString(name, ""))
+}
+
+func (i *RootFolderImport) read(ctx context.Context, lookup *radarr.MovieResource, path string, diags *diag.Diagnostics) *radarr.MovieResource {
+	var tags []int32
+
+	diags.Append(i.Tags.ElementsAs(ctx, &tags, true)...)
+
+	options := radarr.NewAddMovieOptions()
+	options.SetSearchForMovie(false)
+	options.SetMonitor(radarr.MONITORTYPES_MOVIE_ONLY)
+
+	movie := radarr.NewMovieResource()
+	movie.SetTitle(lookup.GetTitle())
+	movie.SetYear(lookup.GetYear())
+	movie.SetTmdbId(lookup.GetTmdbId())
+	movie.SetPath(path)
+	movie.SetQualityProfileId(int32(i.QualityProfileID.ValueInt64()))
+	movie.SetMonitored(i.Monitored.ValueBool())
+	movie.SetMinimumAvailability(radarr.MovieStatusType(i.MinimumAvailability.ValueString()))
+	movie.SetTags(tags)
+	movie.SetAddOptions(*options)
+
+	return movie
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRootFolderImportResource(t *testing.T) {
+	// not parallel, the root folder path is shared with the root folder resource test
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccRootFolderImportResourceConfig(false) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccRootFolderImportResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_root_folder_import.test", "monitored", "false"),
+					resource.TestCheckResourceAttr("radarr_root_folder_import.test", "minimum_availability", "released"),
+					resource.TestCheckResourceAttr("radarr_root_folder_import.test", "matched_folders.#", "0"),
+					resource.TestCheckResourceAttr("radarr_root_folder_import.test", "unmatched_folders.#", "0"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccRootFolderImportResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_root_folder_import.test", "monitored", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRootFolderImportResourceConfig(monitored bool) string {
+	return fmt.Sprintf(`
+		resource "radarr_root_folder" "test" {
+			path = "/config/logs"
+		}
+
+		resource "radarr_root_folder_import" "test" {
+			root_folder_id = radarr_root_folder.test.id
+			quality_profile_id = 1
+			monitored = %t
+		}
+	`, monitored)
+}
+
+const (
+	testRootFolderImportFolder = `{
+		"id": 1,
+		"path": "/movies",
+		"accessible": true,
+		"unmappedFolders": [
+			{"name": "The Matrix (1999) {tmdb-603}", "path": "/movies/The Matrix (1999) {tmdb-603}"},
+			{"name": "Fight Club (1999)", "path": "/movies/Fight Club (1999)"},
+			{"name": "Unknown Movie (2020)", "path": "/movies/Unknown Movie (2020)"}
+		]
+	}`
+	testRootFolderImportMatrix    = `{"title": "The Matrix", "year": 1999, "tmdbId": 603, "imdbId": "tt0133093"}`
+	testRootFolderImportFightClub = `{"title": "Fight Club", "year": 1999, "tmdbId": 550, "imdbId": "tt0137523"}`
+	testRootFolderImportRemake    = `{"title": "Fight Club", "year": 2030, "tmdbId": 9999}`
+	testRootFolderImportOther     = `{"title": "Another Movie", "year": 2020, "tmdbId": 9998}`
+)
+
+// testRootFolderImportServer stubs the endpoints used by the import, since matching relies on external metadata.
+func testRootFolderImportServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "StubAPIKey" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path + "?" + r.URL.Query().Get("term") {
+		case "/api/v3/rootfolder/1?":
+			_, _ = w.Write([]byte(testRootFolderImportFolder))
+		case "/api/v3/movie/lookup?tmdb:603":
+			_, _ = w.Write([]byte("[" + testRootFolderImportMatrix + "]"))
+		case "/api/v3/movie/lookup?Fight Club (1999)":
+			// the best hit is not the right year
+			_, _ = w.Write([]byte("[" + testRootFolderImportRemake + "," + testRootFolderImportFightClub + "]"))
+		case "/api/v3/movie/lookup?Unknown Movie (2020)":
+			_, _ = w.Write([]byte("[" + testRootFolderImportOther + "]"))
+		case "/api/v3/movie/import?":
+			_, _ = w.Write([]byte("[" + testRootFolderImportMatrix + "," + testRootFolderImportFightClub + "]"))
+		case "/api/v3/movie?":
+			_, _ = w.Write([]byte(`[{"id": 1, "tmdbId": 603}, {"id": 2, "tmdbId": 550}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestAccRootFolderImportResourceMatching(t *testing.T) {
+	t.Parallel()
+
+	server := testRootFolderImportServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRootFolderImportResourceStubConfig(server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("radarr_root_folder_import.test", "matched_folders.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("radarr_root_folder_import.test", "matched_folders.*", map[string]string{"name": "The Matrix (1999) {tmdb-603}", "tmdb_id": "603", "movie_id": "1"}),
+					resource.TestCheckTypeSetElemNestedAttrs("radarr_root_folder_import.test", "matched_folders.*", map[string]string{"name": "Fight Club (1999)", "tmdb_id": "550", "movie_id": "2"}),
+					resource.TestCheckResourceAttr("radarr_root_folder_import.test", "unmatched_folders.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("radarr_root_folder_import.test", "unmatched_folders.*", map[string]string{"name": "Unknown Movie (2020)"}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRootFolderImportResourceStubConfig(url string) string {
+	return fmt.Sprintf(`
+	provider "radarr" {
+		url = "%s"
+		api_key = "StubAPIKey"
+	}
+
+	resource "radarr_root_folder_import" "test" {
+		root_folder_id = 1
+		quality_profile_id = 1
+		monitored = true
+	}
+	`, url)
+}
@@ -41,6 +41,8 @@ type RootFolder struct {
 	UnmappedFolders types.Set    `tfsdk:"unmapped_folders"`
 	Path            types.String `tfsdk:"path"`
 	ID              types.Int64  `tfsdk:"id"`
+	FreeSpace       types.Int64  `tfsdk:"free_space"`
+	TotalSpace      types.Int64  `tfsdk:"total_space"`
 	Accessible      types.Bool   `tfsdk:"accessible"`
 }
 
@@ -50,6 +52,8 @@ func (r RootFolder) getType() attr.Type {
 			"unmapped_folders": types.SetType{}.WithElementType(Path{}.getType()),
 			"path":             types.StringType,
 			"id":               types.Int64Type,
+			"free_space":       types.Int64Type,
+			"total_space":      types.Int64Type,
 			"accessible":       types.BoolType,
 		})
 }
@@ -88,6 +92,14 @@ func (r *RootFolderResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				MarkdownDescription: "Access flag.",
 				Computed:            true,
 			},
+			"free_space": schema.Int64Attribute{
+				MarkdownDescription: "Free space in bytes.",
+				Computed:            true,
+			},
+			"total_space": schema.Int64Attribute{
+				MarkdownDescription: "Total space in bytes of the mount containing the root folder.",
+				Computed:            true,
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Root Folder ID.",
 				Computed:            true,
@@ -138,6 +150,14 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// Get disk space to compute total space
+	disks, _, err := r.client.DiskSpaceAPI.ListDiskSpace(r.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, rootFolderResourceName, err))
+
+		return
+	}
+
 	// Create new RootFolder
 	request := *radarr.NewRootFolderResource()
 	request.SetPath(folder.Path.ValueString())
@@ -151,7 +171,7 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 
 	tflog.Trace(ctx, "created "+rootFolderResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
-	folder.write(ctx, response, &resp.Diagnostics)
+	folder.write(ctx, response, disks, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &folder)...)
 }
 
@@ -173,9 +193,17 @@ func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// Get disk space to compute total space
+	disks, _, err := r.client.DiskSpaceAPI.ListDiskSpace(r.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, rootFolderResourceName, err))
+
+		return
+	}
+
 	tflog.Trace(ctx, "read "+rootFolderResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
-	folder.write(ctx, response, &resp.Diagnostics)
+	folder.write(ctx, response, disks, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &folder)...)
 }
 
@@ -209,12 +237,14 @@ func (r *RootFolderResource) ImportState(ctx context.Context, req resource.Impor
 	tflog.Trace(ctx, "imported "+rootFolderResourceName+": "+req.ID)
 }
 
-func (r *RootFolder) write(ctx context.Context, rootFolder *radarr.RootFolderResource, diags *diag.Diagnostics) {
+func (r *RootFolder) write(ctx context.Context, rootFolder *radarr.RootFolderResource, disks []radarr.DiskSpaceResource, diags *diag.Diagnostics) {
 	var tempDiag diag.Diagnostics
 
 	r.Accessible = types.BoolValue(rootFolder.GetAccessible())
 	r.ID = types.Int64Value(int64(rootFolder.GetId()))
 	r.Path = types.StringValue(rootFolder.GetPath())
+	r.FreeSpace = types.Int64PointerValue(rootFolder.FreeSpace.Get())
+	r.TotalSpace = diskTotalSpace(rootFolder.GetPath(), disks)
 
 	unmapped := make([]Path, len(rootFolder.GetUnmappedFolders()))
 	for i, f := range rootFolder.UnmappedFolders {
@@ -26,6 +26,8 @@ func TestAccRootFolderResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("radarr_root_folder.test", "path", "/config/asp"),
 					resource.TestCheckResourceAttrSet("radarr_root_folder.test", "id"),
+					resource.TestCheckResourceAttrSet("radarr_root_folder.test", "free_space"),
+					resource.TestCheckResourceAttrSet("radarr_root_folder.test", "total_space"),
 				),
 			},
 			// Unauthorized read
@@ -59,6 +59,14 @@ func (d *RootFoldersDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 							MarkdownDescription: "Access flag.",
 							Computed:            true,
 						},
+						"free_space": schema.Int64Attribute{
+							MarkdownDescription: "Free space in bytes.",
+							Computed:            true,
+						},
+						"total_space": schema.Int64Attribute{
+							MarkdownDescription: "Total space in bytes of the mount containing the root folder.",
+							Computed:            true,
+						},
 						"id": schema.Int64Attribute{
 							MarkdownDescription: "Root Folder ID.",
 							Computed:            true,
@@ -102,11 +110,19 @@ func (d *RootFoldersDataSource) Read(ctx context.Context, _ datasource.ReadReque
 		return
 	}
 
+	// Get disk space to compute total space
+	disks, _, err := d.client.DiskSpaceAPI.ListDiskSpace(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, rootFoldersDataSourceName, err))
+
+		return
+	}
+
 	tflog.Trace(ctx, "read "+rootFoldersDataSourceName)
 	// Map response body to resource schema attribute
 	rootFolders := make([]RootFolder, len(response))
 	for i, f := range response {
-		rootFolders[i].write(ctx, &f, &resp.Diagnostics)
+		rootFolders[i].write(ctx, &f, disks, &resp.Diagnostics)
 	}
 
 	folderList, diags := types.SetValueFrom(ctx, RootFolder{}.getType(), rootFolders)
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const systemTasksDataSourceName = "system_tasks"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SystemTasksDataSource{}
+
+func NewSystemTasksDataSource() datasource.DataSource {
+	return &SystemTasksDataSource{}
+}
+
+// SystemTasksDataSource defines the system tasks implementation.
+type SystemTasksDataSource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// SystemTasks describes the system tasks data model.
+type SystemTasks struct {
+	Tasks types.Set    `tfsdk:"tasks"`
+	ID    types.String `tfsdk:"id"`
+}
+
+// SystemTask is part of SystemTasks.
+type SystemTask struct {
+	Name          types.String `tfsdk:"name"`
+	TaskName      types.String `tfsdk:"task_name"`
+	LastExecution types.String `tfsdk:"last_execution"`
+	LastDuration  types.String `tfsdk:"last_duration"`
+	NextExecution types.String `tfsdk:"next_execution"`
+	ID            types.Int64  `tfsdk:"id"`
+	Interval      types.Int64  `tfsdk:"interval"`
+}
+
+func (s SystemTask) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name":           types.StringType,
+			"task_name":      types.StringType,
+			"last_execution": types.StringType,
+			"last_duration":  types.StringType,
+			"next_execution": types.StringType,
+			"id":             types.Int64Type,
+			"interval":       types.Int64Type,
+		})
+}
+
+func (d *SystemTasksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + systemTasksDataSourceName
+}
+
+func (d *SystemTasksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:System -->\nList all scheduled tasks.\nFor more information refer to [Tasks](https://wiki.servarr.com/radarr/system#tasks) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"tasks": schema.SetNestedAttribute{
+				MarkdownDescription: "Task list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Task ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Display name.",
+							Computed:            true,
+						},
+						"task_name": schema.StringAttribute{
+							MarkdownDescription: "Task name, e.g. `RssSync`.",
+							Computed:            true,
+						},
+						"interval": schema.Int64Attribute{
+							MarkdownDescription: "Interval in minutes.",
+							Computed:            true,
+						},
+						"last_execution": schema.StringAttribute{
+							MarkdownDescription: "Last execution time, in RFC3339 format.",
+							Computed:            true,
+						},
+						"last_duration": schema.StringAttribute{
+							MarkdownDescription: "Last execution duration, in `hh:mm:ss` format.",
+							Computed:            true,
+						},
+						"next_execution": schema.StringAttribute{
+							MarkdownDescription: "Next execution time, in RFC3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SystemTasksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *SystemTasksDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get system tasks current value
+	response, _, err := d.client.TaskAPI.ListSystemTask(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, systemTasksDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+systemTasksDataSourceName)
+	// Map response body to resource schema attribute
+	tasks := make([]SystemTask, len(response))
+	for i, t := range response {
+		tasks[i].write(&t)
+	}
+
+	taskList, diags := types.SetValueFrom(ctx, SystemTask{}.getType(), tasks)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, SystemTasks{Tasks: taskList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
+
+func (s *SystemTask) write(task *radarr.TaskResource) {
+	s.ID = types.Int64Value(int64(task.GetId()))
+	s.Name = types.StringValue(task.GetName())
+	s.TaskName = types.StringValue(task.GetTaskName())
+	s.Interval = types.Int64Value(int64(task.GetInterval()))
+	s.LastExecution = helpers.TimeValue(task.LastExecution)
+	s.LastDuration = types.StringValue(task.GetLastDuration())
+	s.NextExecution = helpers.TimeValue(task.NextExecution)
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const updateResourceName = "update"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UpdateResource{}
+
+func NewUpdateResource() resource.Resource {
+	return &UpdateResource{}
+}
+
+// UpdateResource defines the update implementation.
+type UpdateResource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// UpdateInstall describes the update data model.
+type UpdateInstall struct {
+	Version         types.String `tfsdk:"version"`
+	PreviousVersion types.String `tfsdk:"previous_version"`
+	Timeout         types.Int64  `tfsdk:"timeout"`
+}
+
+func (r *UpdateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + updateResourceName
+}
+
+func (r *UpdateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nUpdate resource.\nInstalls the given application version on create and whenever `version` changes, waiting for Radarr to restart with it.\nRadarr only installs the latest available [Update](../data-sources/updates), so `version` must match it unless already installed. Destroying it does not downgrade the application.",
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version to install.",
+				Required:            true,
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Seconds to wait for the new version to be running. Defaults to `600`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(commandDefaultTimeout),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"previous_version": schema.StringAttribute{
+				MarkdownDescription: "Version running before the last install.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UpdateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *UpdateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var update *UpdateInstall
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &update)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.install(ctx, update, helpers.Create, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+updateResourceName+": "+update.Version.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &update)...)
+}
+
+func (r *UpdateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Update is an action, keep the last installed version
+	var update *UpdateInstall
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &update)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+updateResourceName+": "+update.Version.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &update)...)
+}
+
+func (r *UpdateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var update *UpdateInstall
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &update)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.install(ctx, update, helpers.Update, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+updateResourceName+": "+update.Version.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &update)...)
+}
+
+func (r *UpdateResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Update cannot be reverted just removing configuration
+	tflog.Trace(ctx, "decoupled "+updateResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// install runs the application update command and waits for the new version to be reported.
+func (r *UpdateResource) install(ctx context.Context, update *UpdateInstall, action string, diags *diag.Diagnostics) {
+	version := update.Version.ValueString()
+
+	status, _, err := r.client.SystemAPI.GetSystemStatus(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, updateResourceName, err))
+
+		return
+	}
+
+	update.PreviousVersion = types.StringValue(status.GetVersion())
+	if status.GetVersion() == version {
+		return
+	}
+
+	updates, _, err := r.client.UpdateAPI.ListUpdate(r.auth).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, updateResourceName, err))
+
+		return
+	}
+
+	if !updateInstallable(version, updates, diags) {
+		return
+	}
+
+	if _, err := helpers.CreateCommand(r.auth, r.client, "ApplicationUpdate", map[string]interface{}{"installMajorUpdate": true}); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, updateResourceName, err))
+
+		return
+	}
+
+	// Radarr restarts during the install, wait for it to come back with the new version
+	timeout := time.Duration(update.Timeout.ValueInt64()) * time.Second
+	if _, err := helpers.WaitSystemStatus(helpers.WithAuth(ctx, r.auth), r.client, timeout, helpers.CommandPollInterval, func(s *radarr.SystemResource) bool {
+		return s.GetVersion() == version
+	}); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, updateResourceName, err))
+	}
+}
+
+// updateInstallable checks that the version is the latest installable update.
+func updateInstallable(version string, updates []radarr.UpdateResource, diags *diag.Diagnostics) bool {
+	for _, u := range updates {
+		if u.GetVersion() != version {
+			continue
+		}
+
+		if !u.GetLatest() || !u.GetInstallable() {
+			diags.AddError(helpers.ResourceError, fmt.Sprintf("Unable to install %s, got error: only the latest installable version can be installed", version))
+
+			return false
+		}
+
+		return true
+	}
+
+	diags.AddError(helpers.ResourceError, helpers.ParseNotFoundError(updateResourceName, "version", version))
+
+	return false
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUpdateResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccUpdateResourceConfig(`"0.0.0.1"`) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found version
+			{
+				Config:      testAccUpdateResourceConfig(`"0.0.0.1"`),
+				ExpectError: regexp.MustCompile("no update with version '0.0.0.1'"),
+			},
+			// Create and Read testing with the running version, nothing is installed
+			{
+				Config: testAccUpdateResourceConfig("data.radarr_system_status.test.version"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("radarr_update.test", "version", "data.radarr_system_status.test", "version"),
+					resource.TestCheckResourceAttrPair("radarr_update.test", "previous_version", "data.radarr_system_status.test", "version"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccUpdateResourceConfig(version string) string {
+	return fmt.Sprintf(`
+		data "radarr_system_status" "test" {
+		}
+
+		resource "radarr_update" "test" {
+			version = %s
+		}
+	`, version)
+}
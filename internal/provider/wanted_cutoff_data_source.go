@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const wantedCutoffDataSourceName = "wanted_cutoff"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WantedCutoffDataSource{}
+
+func NewWantedCutoffDataSource() datasource.DataSource {
+	return &WantedCutoffDataSource{}
+}
+
+// WantedCutoffDataSource defines the wanted cutoff implementation.
+type WantedCutoffDataSource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+func (d *WantedCutoffDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + wantedCutoffDataSourceName
+}
+
+func (d *WantedCutoffDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nList the [Movies](../resources/movie) whose file does not meet the [Quality Profile](../resources/quality_profile) cutoff.\nFor more information refer to [Wanted](https://wiki.servarr.com/radarr/wanted) documentation.",
+		Attributes:          wantedSchemaAttributes(),
+	}
+}
+
+func (d *WantedCutoffDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *WantedCutoffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Wanted
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get wanted cutoff current value
+	response, err := helpers.ListPages[radarr.MovieResource](func(page int32) (*radarr.MovieResourcePagingResource, *http.Response, error) {
+		request := d.client.CutoffAPI.GetWantedCutoff(d.auth).Page(page).PageSize(wantedPageSize)
+		if !data.Monitored.IsNull() {
+			request = request.Monitored(data.Monitored.ValueBool())
+		}
+
+		return request.Execute()
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, wantedCutoffDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+wantedCutoffDataSourceName)
+	data.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWantedCutoffDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccWantedCutoffDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccWantedCutoffDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.radarr_wanted_cutoff.test", "id"),
+					resource.TestCheckResourceAttrSet("data.radarr_wanted_cutoff.unmonitored", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccWantedCutoffDataSourceConfig = `
+data "radarr_wanted_cutoff" "test" {
+}
+
+data "radarr_wanted_cutoff" "unmonitored" {
+	monitored = false
+}
+`
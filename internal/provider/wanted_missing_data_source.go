@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/devopsarr/radarr-go/radarr"
+	"github.com/devopsarr/terraform-provider-radarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	wantedMissingDataSourceName = "wanted_missing"
+	wantedPageSize              = 100
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WantedMissingDataSource{}
+
+func NewWantedMissingDataSource() datasource.DataSource {
+	return &WantedMissingDataSource{}
+}
+
+// WantedMissingDataSource defines the wanted missing implementation.
+type WantedMissingDataSource struct {
+	client *radarr.APIClient
+	auth   context.Context
+}
+
+// Wanted describes the wanted data model.
+type Wanted struct {
+	Movies    types.Set    `tfsdk:"movies"`
+	ID        types.String `tfsdk:"id"`
+	Monitored types.Bool   `tfsdk:"monitored"`
+}
+
+// WantedMovie is part of Wanted.
+type WantedMovie struct {
+	Title               types.String `tfsdk:"title"`
+	Quality             types.String `tfsdk:"quality"`
+	ID                  types.Int64  `tfsdk:"id"`
+	TMDBID              types.Int64  `tfsdk:"tmdb_id"`
+	Year                types.Int64  `tfsdk:"year"`
+	QualityProfileID    types.Int64  `tfsdk:"quality_profile_id"`
+	CustomFormatScore   types.Int64  `tfsdk:"custom_format_score"`
+	Monitored           types.Bool   `tfsdk:"monitored"`
+	QualityCutoffNotMet types.Bool   `tfsdk:"quality_cutoff_not_met"`
+}
+
+func (w WantedMovie) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"title":                  types.StringType,
+			"quality":                types.StringType,
+			"id":                     types.Int64Type,
+			"tmdb_id":                types.Int64Type,
+			"year":                   types.Int64Type,
+			"quality_profile_id":     types.Int64Type,
+			"custom_format_score":    types.Int64Type,
+			"monitored":              types.BoolType,
+			"quality_cutoff_not_met": types.BoolType,
+		})
+}
+
+func (d *WantedMissingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + wantedMissingDataSourceName
+}
+
+func (d *WantedMissingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "<!-- subcategory:Movies -->\nList the available [Movies](../resources/movie) without a file.\nFor more information refer to [Wanted](https://wiki.servarr.com/radarr/wanted) documentation.",
+		Attributes:          wantedSchemaAttributes(),
+	}
+}
+
+func (d *WantedMissingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *WantedMissingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Wanted
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get wanted missing current value
+	response, err := helpers.ListPages[radarr.MovieResource](func(page int32) (*radarr.MovieResourcePagingResource, *http.Response, error) {
+		request := d.client.MissingAPI.GetWantedMissing(d.auth).Page(page).PageSize(wantedPageSize)
+		if !data.Monitored.IsNull() {
+			request = request.Monitored(data.Monitored.ValueBool())
+		}
+
+		return request.Execute()
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, wantedMissingDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+wantedMissingDataSourceName)
+	data.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// wantedSchemaAttributes returns the attributes shared by the wanted data sources.
+func wantedSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"monitored": schema.BoolAttribute{
+			MarkdownDescription: "Filter by monitored flag. Defaults to `true`.",
+			Optional:            true,
+		},
+		"movies": schema.SetNestedAttribute{
+			MarkdownDescription: "Movie list.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						MarkdownDescription: "Movie ID.",
+						Computed:            true,
+					},
+					"tmdb_id": schema.Int64Attribute{
+						MarkdownDescription: "TMDB ID.",
+						Computed:            true,
+					},
+					"title": schema.StringAttribute{
+						MarkdownDescription: "Movie title.",
+						Computed:            true,
+					},
+					"year": schema.Int64Attribute{
+						MarkdownDescription: "Year.",
+						Computed:            true,
+					},
+					"monitored": schema.BoolAttribute{
+						MarkdownDescription: "Monitored flag.",
+						Computed:            true,
+					},
+					"quality_profile_id": schema.Int64Attribute{
+						MarkdownDescription: "Quality profile ID.",
+						Computed:            true,
+					},
+					"quality": schema.StringAttribute{
+						MarkdownDescription: "Movie file quality name. Null if the movie has no file.",
+						Computed:            true,
+					},
+					"custom_format_score": schema.Int64Attribute{
+						MarkdownDescription: "Movie file custom format score. Null if the movie has no file.",
+						Computed:            true,
+					},
+					"quality_cutoff_not_met": schema.BoolAttribute{
+						MarkdownDescription: "Quality cutoff not met flag. Null if the movie has no file.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (w *Wanted) write(ctx context.Context, movies []radarr.MovieResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	wanted := make([]WantedMovie, len(movies))
+	for i, m := range movies {
+		wanted[i].write(&m)
+	}
+
+	w.Movies, tempDiag = types.SetValueFrom(ctx, WantedMovie{}.getType(), wanted)
+	diags.Append(tempDiag...)
+
+	w.ID = types.StringValue(strconv.Itoa(len(movies)))
+}
+
+func (w *WantedMovie) write(movie *radarr.MovieResource) {
+	w.ID = types.Int64Value(int64(movie.GetId()))
+	w.TMDBID = types.Int64Value(int64(movie.GetTmdbId()))
+	w.Title = types.StringValue(movie.GetTitle())
+	w.Year = types.Int64Value(int64(movie.GetYear()))
+	w.Monitored = types.BoolValue(movie.GetMonitored())
+	w.QualityProfileID = types.Int64Value(int64(movie.GetQualityProfileId()))
+	w.Quality = types.StringNull()
+	w.CustomFormatScore = types.Int64Null()
+	w.QualityCutoffNotMet = types.BoolNull()
+
+	if movie.MovieFile != nil {
+		quality := movie.MovieFile.GetQuality()
+		w.Quality = types.StringValue(quality.Quality.GetName())
+		w.CustomFormatScore = types.Int64Value(int64(movie.MovieFile.GetCustomFormatScore()))
+		w.QualityCutoffNotMet = types.BoolValue(movie.MovieFile.GetQualityCutoffNotMet())
+	}
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWantedMissingDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccWantedMissingDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccWantedMissingDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.radarr_wanted_missing.test", "id"),
+					resource.TestCheckResourceAttrSet("data.radarr_wanted_missing.unmonitored", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccWantedMissingDataSourceConfig = `
+data "radarr_wanted_missing" "test" {
+}
+
+data "radarr_wanted_missing" "unmonitored" {
+	monitored = false
+}
+`